@@ -0,0 +1,110 @@
+package agents
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/VasilyKaiser/aquasily/core"
+)
+
+// Agent subscribes to session events and processes them
+type Agent interface {
+	ID() string
+	Register(s *core.Session) error
+}
+
+// Definition describes an agent known to the registry
+type Definition struct {
+	Name string
+	// New returns a new instance of the agent
+	New func() Agent
+}
+
+var (
+	definitionsMutex sync.RWMutex
+	definitions      []Definition
+)
+
+func init() {
+	Register("tcp_port_scanner", func() Agent { return NewTCPPortScanner() })
+	Register("url_publisher", func() Agent { return NewURLPublisher() })
+	Register("url_requester", func() Agent { return NewURLRequester() })
+	Register("url_hostname_resolver", func() Agent { return NewURLHostnameResolver() })
+	Register("url_page_title_extractor", func() Agent { return NewURLPageTitleExtractor() })
+	Register("url_screenshotter", func() Agent { return NewURLScreenshotter() })
+	Register("url_technology_fingerprinter", func() Agent { return NewURLTechnologyFingerprinter() })
+	Register("command_hook", func() Agent { return NewCommandHook() })
+}
+
+// Register adds an agent to the registry. Agents are registered with the
+// session in order of registration, registering an existing name replaces
+// the previous agent while keeping its position.
+func Register(name string, constructor func() Agent) {
+	definitionsMutex.Lock()
+	defer definitionsMutex.Unlock()
+	definition := Definition{Name: name, New: constructor}
+	for i := range definitions {
+		if definitions[i].Name == name {
+			definitions[i] = definition
+			return
+		}
+	}
+	definitions = append(definitions, definition)
+}
+
+// Names returns names of all registered agents in order of registration
+func Names() []string {
+	definitionsMutex.RLock()
+	defer definitionsMutex.RUnlock()
+	var names []string
+	for _, definition := range definitions {
+		names = append(names, definition.Name)
+	}
+	return names
+}
+
+// Select returns new instances of the registered agents named in enabled,
+// or of all of them if enabled is empty, leaving out the ones named in
+// skipped. Names may be given with or without the "agent:" prefix.
+func Select(enabled []string, skipped []string) ([]Agent, error) {
+	definitionsMutex.RLock()
+	defer definitionsMutex.RUnlock()
+	known := make(map[string]bool)
+	for _, definition := range definitions {
+		known[definition.Name] = true
+	}
+	normalize := func(names []string) (map[string]bool, error) {
+		set := make(map[string]bool)
+		for _, name := range names {
+			name = strings.TrimPrefix(strings.TrimSpace(name), "agent:")
+			if name == "" {
+				continue
+			}
+			if !known[name] {
+				return nil, fmt.Errorf("unknown agent: %s", name)
+			}
+			set[name] = true
+		}
+		return set, nil
+	}
+	enabledSet, err := normalize(enabled)
+	if err != nil {
+		return nil, err
+	}
+	skippedSet, err := normalize(skipped)
+	if err != nil {
+		return nil, err
+	}
+	var selected []Agent
+	for _, definition := range definitions {
+		if len(enabledSet) > 0 && !enabledSet[definition.Name] {
+			continue
+		}
+		if skippedSet[definition.Name] {
+			continue
+		}
+		selected = append(selected, definition.New())
+	}
+	return selected, nil
+}
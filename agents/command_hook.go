@@ -0,0 +1,179 @@
+package agents
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/VasilyKaiser/aquasily/core"
+)
+
+// Hook is an external command run on a pipeline event
+type Hook struct {
+	Event   string
+	Command string
+}
+
+// ParseHook returns the hook from a "<event>:<command>" definition,
+// event is either url for responsive URLs or port for open TCP ports
+func ParseHook(definition string) (Hook, error) {
+	event, command, found := strings.Cut(definition, ":")
+	command = strings.TrimSpace(command)
+	if !found || command == "" {
+		return Hook{}, fmt.Errorf("invalid hook %q, expected <event>:<command>", definition)
+	}
+	switch event {
+	case "url", "port":
+		return Hook{Event: event, Command: command}, nil
+	}
+	return Hook{}, fmt.Errorf("invalid hook event %q, expected url or port", event)
+}
+
+// CommandHook runs external commands on pipeline events. The command gets
+// the page, or the host and port, as JSON on stdin and may print JSON with
+// tags and notes to add to the page
+type CommandHook struct {
+	session *core.Session
+	hooks   []Hook
+}
+
+// NewCommandHook returns CommandHook structure
+func NewCommandHook() *CommandHook {
+	return &CommandHook{}
+}
+
+// ID returns name of the source file
+func (a *CommandHook) ID() string {
+	return "agent:command_hook"
+}
+
+// Register is registering for EventBus TCPPort events and for the end of
+// URLResponsive events of the configured hooks
+func (a *CommandHook) Register(s *core.Session) error {
+	a.session = s
+	subscribed := make(map[string]bool)
+	for _, definition := range *s.Options.Hooks {
+		hook, err := ParseHook(definition)
+		if err != nil {
+			return err
+		}
+		a.hooks = append(a.hooks, hook)
+		if subscribed[hook.Event] {
+			continue
+		}
+		subscribed[hook.Event] = true
+		if hook.Event == "url" {
+			// Hooks get the page once all page agents are done with it, the
+			// empty handler makes sure that happens without page agents too
+			s.OnEventDone(core.URLResponsive, func(args []interface{}) {
+				a.OnURLResponsive(args[0].(string))
+			})
+			err = s.Subscribe(core.URLResponsive, func(string) {})
+		} else {
+			err = s.Subscribe(core.TCPPort, a.OnTCPPort)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnURLResponsive runs url hooks with the page once the other agents
+// have finished with it
+func (a *CommandHook) OnURLResponsive(url string) {
+	a.session.Out.Debug("[%s] Received new responsive URL %s\n", a.ID(), url)
+	page := a.session.GetPage(url)
+	if page == nil {
+		a.session.Out.Error("[%s] Unable to find page for URL: %s\n", a.ID(), url)
+		return
+	}
+	input, err := json.Marshal(page)
+	if err != nil {
+		a.session.Out.Error("[%s] %s\n", a.ID(), err)
+		return
+	}
+	for _, annotation := range a.run("url", url, input) {
+		page.Annotate(annotation)
+	}
+}
+
+// OnTCPPort runs port hooks with the host and port
+func (a *CommandHook) OnTCPPort(port int, host string) {
+	a.session.Out.Debug("[%s] Received new open port on %s: %d\n", a.ID(), host, port)
+	input, err := json.Marshal(struct {
+		Host string `json:"host"`
+		Port int    `json:"port"`
+	}{host, port})
+	if err != nil {
+		a.session.Out.Error("[%s] %s\n", a.ID(), err)
+		return
+	}
+	for _, annotation := range a.run("port", fmt.Sprintf("%s:%d", host, port), input) {
+		a.session.AnnotatePort(host, port, annotation)
+	}
+}
+
+// run runs every hook of the event and returns their parsed outputs
+func (a *CommandHook) run(event string, subject string, input []byte) (annotations []core.Annotation) {
+	for _, hook := range a.hooks {
+		if hook.Event != event {
+			continue
+		}
+		if err := a.session.Pool(core.FingerprintPool).AddWithContext(a.session.Context()); err != nil {
+			return
+		}
+		output, err := a.execute(hook, input)
+		a.session.Pool(core.FingerprintPool).Done()
+		if a.session.Canceled() {
+			return
+		}
+		if err != nil {
+			a.session.Out.Error("%s: hook %q failed: %s\n", subject, hook.Command, err)
+			continue
+		}
+		if len(bytes.TrimSpace(output)) == 0 {
+			continue
+		}
+		var annotation core.Annotation
+		if err := json.Unmarshal(output, &annotation); err != nil {
+			a.session.Out.Error("%s: hook %q returned invalid JSON: %s\n", subject, hook.Command, err)
+			continue
+		}
+		a.session.Out.Debug("[%s] Hook %q returned %d tags and %d notes for %s\n", a.ID(), hook.Command, len(annotation.Tags), len(annotation.Notes), subject)
+		annotations = append(annotations, annotation)
+	}
+	return annotations
+}
+
+// execute runs the hook command through the shell with input on stdin
+func (a *CommandHook) execute(hook Hook, input []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(a.session.Context(), time.Duration(*a.session.Options.HookTimeout)*time.Second)
+	defer cancel()
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd", "/C", hook.Command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", hook.Command)
+	}
+	outDir, _ := filepath.Abs(*a.session.Options.OutDir)
+	cmd.Env = append(os.Environ(), "AQUASILY_EVENT="+hook.Event, "AQUASILY_OUT_DIR="+outDir)
+	cmd.Stdin = bytes.NewReader(input)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return nil, fmt.Errorf("%s: %s", err, message)
+		}
+		return nil, err
+	}
+	return stdout.Bytes(), nil
+}
@@ -3,6 +3,8 @@ package agents
 import (
 	"fmt"
 	"net"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/VasilyKaiser/aquasily/core"
@@ -25,32 +27,53 @@ func (a *TCPPortScanner) ID() string {
 
 // Register is registering for EventBus Host events
 func (a *TCPPortScanner) Register(s *core.Session) error {
-	s.EventBus.SubscribeAsync(core.Host, a.OnHost, false)
 	a.session = s
-	return nil
+	if proxyURL := s.Proxies.ForDial(); proxyURL != nil && proxyURL.Scheme != "socks5" {
+		// A port counts as open once CONNECT succeeds, which proxies may
+		// confirm for every port or refuse for all but 80 and 443
+		s.Out.Warn("Port scans go through the HTTP proxy %s, which may report every port as open or as closed. Use a SOCKS5 proxy or skip the %s agent for reliable results\n", proxyURL.Redacted(), "tcp_port_scanner")
+	}
+	return s.Subscribe(core.Host, a.OnHost)
 }
 
 // OnHost scans the host
 func (a *TCPPortScanner) OnHost(host string) {
 	a.session.Out.Debug("[%s] Received new host: %s\n", a.ID(), host)
+	if !a.session.InScope(host) {
+		return
+	}
+	var wg sync.WaitGroup
 	for _, port := range a.session.Ports {
-		a.session.WaitGroup.Add()
+		if err := a.session.Pool(core.PortScanPool).AddWithContext(a.session.Context()); err != nil {
+			break
+		}
+		wg.Add(1)
 		go func(port int, host string) {
-			defer a.session.WaitGroup.Done()
-			if a.scanPort(port, host) {
+			defer wg.Done()
+			defer a.session.Pool(core.PortScanPool).Done()
+			open := a.scanPort(port, host)
+			if a.session.Canceled() {
+				return
+			}
+			if open {
 				a.session.Stats.IncrementPortOpen()
 				a.session.Out.Info("%s: port %s %s\n", host, Green(fmt.Sprintf("%d", port)), Green("open"))
-				a.session.EventBus.Publish(core.TCPPort, port, host)
+				a.session.Publish(core.TCPPort, port, host)
 			} else {
 				a.session.Stats.IncrementPortClosed()
 				a.session.Out.Debug("[%s] Port %d is closed on %s\n", a.ID(), port, host)
 			}
 		}(port, host)
 	}
+	wg.Wait()
 }
 
 func (a *TCPPortScanner) scanPort(port int, host string) bool {
-	conn, _ := net.DialTimeout("tcp", fmt.Sprintf("%s:%d", host, port), time.Duration(*a.session.Options.ScanTimeout)*time.Millisecond)
+	if err := a.session.Throttle(host); err != nil {
+		return false
+	}
+	dialer := &net.Dialer{Timeout: time.Duration(*a.session.Options.ScanTimeout) * time.Millisecond}
+	conn, _ := a.session.DialContext(a.session.Context(), dialer, "tcp", net.JoinHostPort(host, strconv.Itoa(port)))
 	if conn != nil {
 		conn.Close()
 		return true
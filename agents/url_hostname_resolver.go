@@ -24,10 +24,8 @@ func (a *URLHostnameResolver) ID() string {
 
 // Register is registering for EventBus URLResponsive events
 func (a *URLHostnameResolver) Register(s *core.Session) error {
-	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
 	a.session = s
-
-	return nil
+	return s.Subscribe(core.URLResponsive, a.OnURLResponsive)
 }
 
 // OnURLResponsive is resolving the host/IP provided
@@ -40,19 +38,31 @@ func (a *URLHostnameResolver) OnURLResponsive(url string) {
 	}
 	if page.IsIPHost() {
 		a.session.Out.Debug("[%s] Skipping hostname resolving on IP host: %s\n", a.ID(), url)
+		page.Lock()
 		page.Addrs = []string{page.ParsedURL().Hostname()}
+		page.Unlock()
+		return
+	}
+
+	if address := a.session.ConnectAddress(page.ParsedURL().Hostname()); address != "" {
+		a.session.Out.Debug("[%s] Using virtual host IP address for: %s\n", a.ID(), url)
+		page.Lock()
+		page.Addrs = []string{address}
+		page.Unlock()
 		return
 	}
 
-	a.session.WaitGroup.Add()
-	go func(page *core.Page) {
-		defer a.session.WaitGroup.Done()
-		addrs, err := net.LookupHost(fmt.Sprintf("%s.", page.ParsedURL().Hostname()))
-		if err != nil {
-			a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
-			a.session.Out.Error("Failed to resolve hostname for %s\n", page.URL)
-			return
-		}
-		page.Addrs = addrs
-	}(page)
+	if err := a.session.Pool(core.HTTPPool).AddWithContext(a.session.Context()); err != nil {
+		return
+	}
+	defer a.session.Pool(core.HTTPPool).Done()
+	addrs, err := net.DefaultResolver.LookupHost(a.session.Context(), fmt.Sprintf("%s.", page.ParsedURL().Hostname()))
+	if err != nil {
+		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
+		a.session.Out.Error("Failed to resolve hostname for %s\n", page.URL)
+		return
+	}
+	page.Lock()
+	page.Addrs = addrs
+	page.Unlock()
 }
@@ -25,10 +25,8 @@ func (a *URLPageTitleExtractor) ID() string {
 
 // Register is registering for EventBus URLResponsive events
 func (a *URLPageTitleExtractor) Register(s *core.Session) error {
-	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
 	a.session = s
-
-	return nil
+	return s.Subscribe(core.URLResponsive, a.OnURLResponsive)
 }
 
 // OnURLResponsive extracts the page title
@@ -39,22 +37,24 @@ func (a *URLPageTitleExtractor) OnURLResponsive(url string) {
 		a.session.Out.Error("[%s] Unable to find page for URL: %s\n", a.ID(), url)
 		return
 	}
-	a.session.WaitGroup.Add()
-	go func(page *core.Page) {
-		defer a.session.WaitGroup.Done()
-		body, err := a.session.ReadFile(fmt.Sprintf("html/%s.html", page.BaseFilename()))
-		if err != nil {
-			a.session.Out.Debug("[%s] Error reading HTML body file for %s: %s\n", a.ID(), page.URL, err)
-			return
-		}
-		doc, err := html.Parse(bytes.NewReader(body))
-		if err != nil {
-			a.session.Out.Debug("[%s] Error when parsing HTML body file for %s: %s\n", a.ID(), page.URL, err)
-			return
-		}
-		a.session.Out.Debug("[%s] Extracting title from: %v\n", a.ID(), page.Hostname)
-		page.PageTitle = pageTitle(doc)
-	}(page)
+	if err := a.session.Pool(core.FingerprintPool).AddWithContext(a.session.Context()); err != nil {
+		return
+	}
+	defer a.session.Pool(core.FingerprintPool).Done()
+	body, err := a.session.ReadFile(fmt.Sprintf("html/%s.html", page.BaseFilename()))
+	if err != nil {
+		a.session.Out.Debug("[%s] Error reading HTML body file for %s: %s\n", a.ID(), page.URL, err)
+		return
+	}
+	doc, err := html.Parse(bytes.NewReader(body))
+	if err != nil {
+		a.session.Out.Debug("[%s] Error when parsing HTML body file for %s: %s\n", a.ID(), page.URL, err)
+		return
+	}
+	a.session.Out.Debug("[%s] Extracting title from: %v\n", a.ID(), page.Hostname)
+	page.Lock()
+	page.PageTitle = pageTitle(doc)
+	page.Unlock()
 }
 
 // pageTitle given a reference to a html.Node, scans it until it
@@ -1,9 +1,10 @@
 package agents
 
 import (
+	"context"
 	"crypto/tls"
-	"fmt"
 	"net"
+	"strconv"
 	"time"
 
 	"github.com/VasilyKaiser/aquasily/core"
@@ -26,21 +27,27 @@ func (a *URLPublisher) ID() string {
 
 // Register is registering for EventBus TCPPort events
 func (a *URLPublisher) Register(s *core.Session) error {
-	s.EventBus.SubscribeAsync(core.TCPPort, a.OnTCPPort, false)
 	a.session = s
-	return nil
+	return s.Subscribe(core.TCPPort, a.OnTCPPort)
 }
 
 // OnTCPPort constructs URL and publishes it to EventBus
 func (a *URLPublisher) OnTCPPort(port int, host string) {
 	a.session.Out.Debug("[%s] Received new open port on %s: %d\n", a.ID(), host, port)
 	var url string
-	if a.isTLS(port, host) {
+	useTLS := a.isTLS(port, host)
+	if a.session.Canceled() {
+		return
+	}
+	if useTLS {
 		url = core.HostAndPortToURL(host, port, "https")
 	} else {
 		url = core.HostAndPortToURL(host, port, "http")
 	}
-	a.session.EventBus.Publish(core.URL, url)
+	if !a.session.InScope(url) {
+		return
+	}
+	a.session.Publish(core.URL, url)
 }
 
 func (a *URLPublisher) isTLS(port int, host string) bool {
@@ -50,14 +57,21 @@ func (a *URLPublisher) isTLS(port int, host string) bool {
 	if port == 443 {
 		return true
 	}
-	dialer := &net.Dialer{Timeout: time.Duration(*a.session.Options.HTTPTimeout) * time.Millisecond}
-	conf := &tls.Config{
-		InsecureSkipVerify: true,
+	if err := a.session.Throttle(host); err != nil {
+		return false
 	}
-	conn, err := tls.DialWithDialer(dialer, "tcp", fmt.Sprintf("%s:%d", host, port), conf)
+	ctx, cancel := context.WithTimeout(a.session.Context(), time.Duration(*a.session.Options.HTTPTimeout)*time.Millisecond)
+	defer cancel()
+	conn, err := a.session.DialContext(ctx, &net.Dialer{}, "tcp", net.JoinHostPort(host, strconv.Itoa(port)))
 	if err != nil {
 		return false
 	}
-	conn.Close()
-	return true
+	defer conn.Close()
+	config := &tls.Config{
+		InsecureSkipVerify: true,
+	}
+	if net.ParseIP(host) == nil {
+		config.ServerName = host
+	}
+	return tls.Client(conn, config).HandshakeContext(ctx) == nil
 }
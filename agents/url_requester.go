@@ -2,10 +2,13 @@ package agents
 
 import (
 	"fmt"
-	"io"
 	"net/http"
+	"net/http/httptrace"
+	"net/textproto"
 	"os"
+	"sort"
 	"strings"
+	"sync"
 
 	"github.com/VasilyKaiser/aquasily/core"
 )
@@ -13,6 +16,8 @@ import (
 // URLRequester structure
 type URLRequester struct {
 	session *core.Session
+	spoofer *HeaderSpoofer
+	client  *http.Client
 }
 
 // NewURLRequester returns URLRequester structure
@@ -27,65 +32,89 @@ func (a *URLRequester) ID() string {
 
 // Register is registering for EventBus URL events
 func (a *URLRequester) Register(s *core.Session) error {
-	s.EventBus.SubscribeAsync(core.URL, a.OnURL, false)
 	a.session = s
-	return nil
+	spoofer, err := NewHeaderSpoofer(*s.Options.SpoofHeaders)
+	if err != nil {
+		return err
+	}
+	a.spoofer = spoofer
+	a.client = MakeClient(s)
+	return s.Subscribe(core.URL, a.OnURL)
 }
 
 // OnURL makes request, saves its body and publishes URLResponsive to the EventBus
 func (a *URLRequester) OnURL(url string) {
 	a.session.Out.Debug("[%s] Received new URL %s\n", a.ID(), url)
-	a.session.WaitGroup.Add()
-	go func(url string) {
-		defer a.session.WaitGroup.Done()
-		var status string
-		client := MakeClient(a.session.Options)
-		req, err := http.NewRequest("GET", url, nil)
-		if err != nil {
-			a.session.Out.Error("[%s] error constructing a new request for: %s\n", a.ID(), url)
-			return
-		}
-		req.Header.Add("User-Agent", RandomUserAgent())
-		req.Header.Add("X-Forwarded-For", RandomIPv4Address())
-		req.Header.Add("Via", fmt.Sprintf("1.1 %s", RandomIPv4Address()))
-		req.Header.Add("Forwarded", fmt.Sprintf("for=%s;proto=http;by=%s", RandomIPv4Address(), RandomIPv4Address()))
-		resp, err := client.Do(req)
-		if err != nil {
-			a.session.Stats.IncrementRequestFailed()
-			a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
-			a.session.Out.Debug("%s: failed\n", url)
+	if !a.session.InScope(url) {
+		return
+	}
+	if err := a.session.Pool(core.HTTPPool).AddWithContext(a.session.Context()); err != nil {
+		return
+	}
+	defer a.session.Pool(core.HTTPPool).Done()
+	var status string
+	req, err := http.NewRequestWithContext(a.session.Context(), "GET", url, nil)
+	if err != nil {
+		a.session.Out.Error("[%s] error constructing a new request for: %s\n", a.ID(), url)
+		return
+	}
+	req.Header.Add("User-Agent", RandomUserAgent())
+	for name, value := range a.spoofer.Headers(req.URL.Scheme) {
+		req.Header.Set(name, value)
+	}
+	SetRequestHeaders(a.session, req)
+	sent := &sentHeaders{secret: a.secretHeaders(url)}
+	req = req.WithContext(httptrace.WithClientTrace(req.Context(), sent.trace()))
+	if err := a.session.Throttle(req.URL.Hostname()); err != nil {
+		return
+	}
+	resp, err := a.client.Do(req)
+	if err != nil {
+		if a.session.Canceled() {
 			return
 		}
-		defer resp.Body.Close()
-
-		a.session.Stats.IncrementRequestSuccessful()
-		if resp.StatusCode >= 500 {
-			a.session.Stats.IncrementResponseCode5xx()
-			status = Red(resp.Status)
-		} else if resp.StatusCode >= 400 {
-			a.session.Stats.IncrementResponseCode4xx()
-			status = Yellow(resp.Status)
-		} else if resp.StatusCode >= 300 {
-			a.session.Stats.IncrementResponseCode3xx()
-			status = Green(resp.Status)
-		} else {
-			a.session.Stats.IncrementResponseCode2xx()
-			status = Green(resp.Status)
-		}
-		a.session.Out.Info("%s: %s\n", url, status)
+		a.session.Stats.IncrementRequestFailed()
+		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
+		a.session.Out.Debug("%s: failed\n", url)
+		return
+	}
+	defer resp.Body.Close()
 
-		page, err := a.createPageFromResponse(url, resp)
-		if err != nil {
-			a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
-			a.session.Out.Error("Failed to create page for URL: %s\n", url)
-			return
-		}
-		a.writeHeaders(page)
-		if *a.session.Options.SaveBody {
-			a.writeBody(page, resp)
-		}
-		a.session.EventBus.Publish(core.URLResponsive, url)
-	}(url)
+	a.session.Stats.IncrementRequestSuccessful()
+	if resp.StatusCode >= 500 {
+		a.session.Stats.IncrementResponseCode5xx()
+		status = Red(resp.Status)
+	} else if resp.StatusCode >= 400 {
+		a.session.Stats.IncrementResponseCode4xx()
+		status = Yellow(resp.Status)
+	} else if resp.StatusCode >= 300 {
+		a.session.Stats.IncrementResponseCode3xx()
+		status = Green(resp.Status)
+	} else {
+		a.session.Stats.IncrementResponseCode2xx()
+		status = Green(resp.Status)
+	}
+	a.session.Out.Info("%s: %s\n", url, status)
+
+	page, err := a.createPageFromResponse(url, resp)
+	if err != nil {
+		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
+		a.session.Out.Error("Failed to create page for URL: %s\n", url)
+		return
+	}
+	page.Lock()
+	page.RequestHeaders = sent.Headers()
+	page.Unlock()
+	a.writeHeaders(page)
+	if *a.session.Options.SaveBody {
+		a.writeBody(page, resp)
+	}
+	a.session.Publish(core.URLResponsive, url)
+
+	if *a.session.Options.PublishFinalURL && page.FinalURL != url && a.session.InScope(page.FinalURL) {
+		a.session.Out.Debug("[%s] Publishing final URL %s of %s\n", a.ID(), page.FinalURL, url)
+		a.session.Publish(core.URL, page.FinalURL)
+	}
 }
 
 func (a *URLRequester) createPageFromResponse(url string, resp *http.Response) (*core.Page, error) {
@@ -94,13 +123,113 @@ func (a *URLRequester) createPageFromResponse(url string, resp *http.Response) (
 		return nil, err
 	}
 
+	page.Lock()
 	page.Status = resp.Status
+	page.FinalURL = resp.Request.URL.String()
+	page.Redirects = redirectChain(resp)
+	page.Unlock()
+	if target := a.session.GetTarget(url); target != nil {
+		page.AddTargetInfo(target)
+	}
+	a.session.ApplyPortAnnotations(page)
 	for name, value := range resp.Header {
 		page.AddHeader(name, strings.Join(value, " "))
 	}
 	return page, nil
 }
 
+// redactedValue replaces the values of secret headers in the session
+const redactedValue = "[redacted]"
+
+// secretHeaders returns the canonical names of the headers whose values
+// must not be stored: credentials, custom headers and recorded headers
+func (a *URLRequester) secretHeaders(url string) map[string]bool {
+	secret := map[string]bool{"Authorization": true, "Proxy-Authorization": true, "Cookie": true}
+	for name := range a.session.Credentials.Headers {
+		secret[textproto.CanonicalMIMEHeaderKey(name)] = true
+	}
+	if target := a.session.GetTarget(url); target != nil {
+		for name := range target.Headers {
+			secret[textproto.CanonicalMIMEHeaderKey(name)] = true
+		}
+	}
+	return secret
+}
+
+// sentHeaders records the header fields written for the first request
+// of a redirect chain, as they were sent on the wire, with the values
+// of secret headers redacted
+type sentHeaders struct {
+	sync.Mutex
+	secret  map[string]bool
+	headers []core.Header
+	done    bool
+}
+
+func (h *sentHeaders) trace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		WroteHeaderField: func(name string, values []string) {
+			h.Lock()
+			defer h.Unlock()
+			if h.done {
+				return
+			}
+			value := strings.Join(values, " ")
+			if h.secret[textproto.CanonicalMIMEHeaderKey(name)] {
+				value = redactedValue
+			}
+			h.headers = append(h.headers, core.Header{Name: name, Value: value})
+		},
+		WroteHeaders: func() {
+			h.Lock()
+			defer h.Unlock()
+			h.done = true
+		},
+	}
+}
+
+// Headers returns the recorded header fields
+func (h *sentHeaders) Headers() []core.Header {
+	h.Lock()
+	defer h.Unlock()
+	return h.headers
+}
+
+// redirectChain returns the redirects which led to the response in order,
+// ending with the response itself if it is a redirect which wasn't followed
+func redirectChain(resp *http.Response) []core.Redirect {
+	var responses []*http.Response
+	for r := resp.Request.Response; r != nil; r = r.Request.Response {
+		responses = append([]*http.Response{r}, responses...)
+	}
+	if resp.StatusCode >= 300 && resp.StatusCode < 400 && resp.Header.Get("Location") != "" {
+		responses = append(responses, resp)
+	}
+	var chain []core.Redirect
+	for _, r := range responses {
+		location := r.Header.Get("Location")
+		if u, err := r.Location(); err == nil {
+			location = u.String()
+		}
+		chain = append(chain, core.NewRedirect(r.Request.URL.String(), r.Status, location, responseHeaders(r)))
+	}
+	return chain
+}
+
+// responseHeaders returns the headers of the response sorted by name
+func responseHeaders(resp *http.Response) []core.Header {
+	names := make([]string, 0, len(resp.Header))
+	for name := range resp.Header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var headers []core.Header
+	for _, name := range names {
+		headers = append(headers, core.Header{Name: name, Value: strings.Join(resp.Header[name], " ")})
+	}
+	return headers
+}
+
 func (a *URLRequester) writeHeaders(page *core.Page) {
 	filepath := fmt.Sprintf("headers/%s.txt", page.BaseFilename())
 	headers := fmt.Sprintf("%s\n", page.Status)
@@ -111,12 +240,14 @@ func (a *URLRequester) writeHeaders(page *core.Page) {
 		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
 		a.session.Out.Error("Failed to write HTTP response headers for %s to %s\n", page.URL, a.session.GetFilePath(filepath))
 	}
+	page.Lock()
 	page.HeadersPath = filepath
+	page.Unlock()
 }
 
 func (a *URLRequester) writeBody(page *core.Page, resp *http.Response) {
 	filepath := fmt.Sprintf("html/%s.html", page.BaseFilename())
-	body, err := io.ReadAll(resp.Body)
+	body, err := ReadBody(a.session, resp.Body)
 	if err != nil {
 		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
 		a.session.Out.Error("Failed to read response body for %s\n", page.URL)
@@ -127,5 +258,7 @@ func (a *URLRequester) writeBody(page *core.Page, resp *http.Response) {
 		a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
 		a.session.Out.Error("Failed to write HTTP response body for %s to %s\n", page.URL, a.session.GetFilePath(filepath))
 	}
+	page.Lock()
 	page.BodyPath = filepath
+	page.Unlock()
 }
@@ -3,12 +3,16 @@ package agents
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/VasilyKaiser/aquasily/core"
+	"github.com/chromedp/cdproto/cdp"
+	"github.com/chromedp/cdproto/fetch"
+	"github.com/chromedp/cdproto/network"
 	"github.com/chromedp/chromedp"
 )
 
@@ -16,6 +20,8 @@ import (
 type URLScreenshotter struct {
 	session         *core.Session
 	tempUserDirPath string
+	proxyUsername   string
+	proxyPassword   string
 }
 
 // NewURLScreenshotter returns URLScreenshotter structure
@@ -28,14 +34,19 @@ func (a *URLScreenshotter) ID() string {
 	return "agent:url_screenshotter"
 }
 
-// Register is registering for EventBus URLResponsive and SessionEnd events
+// Register is registering for EventBus URLResponsive events, the temporary
+// directory is removed when the session is closed
 func (a *URLScreenshotter) Register(s *core.Session) error {
-	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
-	s.EventBus.SubscribeAsync(core.SessionEnd, a.OnSessionEnd, false)
 	a.session = s
-	a.createTempUserDir()
-
-	return nil
+	var err error
+	if a.proxyUsername, a.proxyPassword, err = s.Proxies.BrowserCredentials(); err != nil {
+		return err
+	}
+	if err := a.createTempUserDir(); err != nil {
+		return err
+	}
+	s.AddCleanup(a.removeTempUserDir)
+	return s.Subscribe(core.URLResponsive, a.OnURLResponsive)
 }
 
 // OnURLResponsive takes screenshot of the page
@@ -46,31 +57,36 @@ func (a *URLScreenshotter) OnURLResponsive(url string) {
 		a.session.Out.Error("Unable to find page for URL: %s\n", url)
 		return
 	}
-	a.session.WaitGroup.Add()
-	time.Sleep(2 * time.Second)
-	go func(page *core.Page) {
-		defer a.session.WaitGroup.Done()
-		a.screenshotPage(page)
-	}(page)
+	if !a.session.InScope(url) {
+		return
+	}
+	if err := a.session.Pool(core.ScreenshotPool).AddWithContext(a.session.Context()); err != nil {
+		return
+	}
+	defer a.session.Pool(core.ScreenshotPool).Done()
+	a.screenshotPage(page)
 }
 
-// OnSessionEnd removes temp directory
-func (a *URLScreenshotter) OnSessionEnd() {
-	a.session.Out.Debug("[%s] Received SessionEnd event\n", a.ID())
+// removeTempUserDir removes the temporary user directory
+func (a *URLScreenshotter) removeTempUserDir() {
 	os.RemoveAll(a.tempUserDirPath)
 	a.session.Out.Debug("[%s] Deleted temporary user directory at: %s\n", a.ID(), a.tempUserDirPath)
 }
 
-func (a *URLScreenshotter) createTempUserDir() {
+func (a *URLScreenshotter) createTempUserDir() error {
 	dir, err := os.MkdirTemp("", "aquatone-browser")
 	if err != nil {
-		a.session.Out.Fatal("[%s] Unable to create temporary user directory for Chrome/Chromium browser: %s\n", a.ID(), err.Error())
+		return fmt.Errorf("unable to create temporary user directory for Chrome/Chromium browser: %s", err)
 	}
 	a.session.Out.Debug("[%s] Created temporary user directory at: %s\n", a.ID(), dir)
 	a.tempUserDirPath = dir
+	return nil
 }
 
 func (a *URLScreenshotter) screenshotPage(page *core.Page) {
+	if err := a.session.Throttle(page.ParsedURL().Hostname()); err != nil {
+		return
+	}
 	filePath := fmt.Sprintf("screenshots/%s.png", page.BaseFilename())
 	resolution := strings.Split(*a.session.Options.Resolution, ",")
 
@@ -86,31 +102,59 @@ func (a *URLScreenshotter) screenshotPage(page *core.Page) {
 		chromedp.DisableGPU,
 		chromedp.IgnoreCertErrors,
 	}
+	if address := a.session.ConnectAddress(page.ParsedURL().Hostname()); address != "" {
+		opts = append(opts, chromedp.Flag("host-resolver-rules", fmt.Sprintf("MAP %s %s", page.ParsedURL().Hostname(), hostResolverAddress(address))))
+	}
 	if *a.session.Options.BrowserPath != "" {
 		opts = append(opts, chromedp.ExecPath(*a.session.Options.BrowserPath))
 	}
-	// if *a.session.Options.Proxy != "" {
-	// 	opts = append(opts, chromedp.ProxyServer(*a.session.Options.Proxy))
-	// }
+	if server := a.session.Proxies.BrowserProxyServer(); server != "" {
+		// Chrome bypasses proxies for localhost unless told otherwise
+		opts = append(opts, chromedp.ProxyServer(server), chromedp.Flag("proxy-bypass-list", "<-loopback>"))
+	}
 	c1 := make(chan string, 1)
-	ctx, cancelExec := chromedp.NewExecAllocator(context.Background(), opts...)
+	var buf []byte
+	ctx, cancelExec := chromedp.NewExecAllocator(a.session.Context(), opts...)
 	defer cancelExec()
 	ctx, cancel := chromedp.NewContext(ctx)
 	defer cancel()
+	tasks := chromedp.Tasks{}
+	if a.session.Scope != nil || len(a.session.Exclusions) > 0 || len(a.session.Credentials.Auth) > 0 || a.proxyUsername != "" || !*a.session.Options.FollowRedirects {
+		a.interceptRequests(ctx)
+		patterns := []*fetch.RequestPattern{{URLPattern: "*", RequestStage: fetch.RequestStageRequest}}
+		if !*a.session.Options.FollowRedirects {
+			patterns = append(patterns, &fetch.RequestPattern{URLPattern: "*", ResourceType: network.ResourceTypeDocument, RequestStage: fetch.RequestStageResponse})
+		}
+		tasks = append(tasks, fetch.Enable().WithPatterns(patterns).WithHandleAuthRequests(a.proxyUsername != ""))
+	}
+	headers := make(map[string]string)
+	if target := a.session.GetTarget(page.URL); target != nil {
+		for name, value := range target.Headers {
+			headers[name] = value
+		}
+	}
+	for name, value := range a.session.Credentials.Headers {
+		headers[name] = value
+	}
+	if len(headers) > 0 {
+		tasks = append(tasks, setRequestHeaders(page.URL, headers))
+	}
+	if len(a.session.Credentials.Cookies) > 0 {
+		tasks = append(tasks, setCookies(a.session.Credentials.Cookies))
+	}
+	tasks = append(tasks, takeScreenshot(page.URL, &buf))
 	a.session.Out.Debug("[%v] Attending to capture: %s\n", a.ID(), page.URL)
-	var buf []byte
 	var outOfTime = false
 	var dtstart time.Time
 	var dtend time.Time
 	go func() {
 		dtstart = time.Now()
 		capturePart := func() string {
-			if err := chromedp.Run(ctx, takeScreenshot(page.URL, &buf)); err != nil {
+			if err := chromedp.Run(ctx, tasks); err != nil {
 				if err.Error() != "context canceled" && !outOfTime {
 					a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
 					a.session.Stats.IncrementScreenshotFailed()
 					a.session.Out.Error("%s: Screenshot failed: %s\n", page.URL, err)
-					cancel()
 				}
 				return "not done"
 			}
@@ -132,8 +176,10 @@ func (a *URLScreenshotter) screenshotPage(page *core.Page) {
 		if res == "done" && outOfTime {
 			return
 		} else if res == "done" {
+			page.Lock()
 			page.ScreenshotPath = filePath
 			page.HasScreenshot = true
+			page.Unlock()
 			a.session.Out.Debug("%s done - screenshot: %v\n", page.URL, page.HasScreenshot)
 			a.session.Stats.IncrementScreenshotSuccessful()
 			a.session.Out.Info("%s: %s %s\n", page.URL, Green("screenshot successful"), dtend.Sub(dtstart).Round(time.Second))
@@ -151,22 +197,151 @@ func (a *URLScreenshotter) screenshotPage(page *core.Page) {
 		}
 		dtend = time.Now()
 		if err := os.WriteFile(a.session.GetFilePath(filePath), buf, 0o644); err != nil {
-			a.session.Out.Error("[%s] Error while writing to file: %\n", a.ID(), err.Error())
+			a.session.Out.Error("[%s] Error while writing to file: %s\n", a.ID(), err.Error())
 			a.session.Stats.IncrementScreenshotFailed()
 			return
 		}
 		a.session.Stats.IncrementScreenshotSuccessful()
 		a.session.Out.Info("%s: %s %s\n", page.URL, Green("screenshot successful"), dtend.Sub(dtstart).Round(time.Second))
 		cancel()
+		page.Lock()
 		page.ScreenshotPath = filePath
 		page.HasScreenshot = true
+		page.Unlock()
 		a.session.Out.Debug("%s after timeout - screenshot: %v\n", page.URL, page.HasScreenshot)
 		return
 	}
+	page.Lock()
 	page.HasScreenshot = false
+	page.Unlock()
 	a.session.Out.Debug("%s screenshot: %v\n", page.URL, page.HasScreenshot)
 }
 
+// interceptRequests intercepts all requests made by the browser, fails
+// those which lead out of scope, adds authentication for matching hosts,
+// stops at redirects when they are not followed and answers authentication
+// challenges of the proxy
+func (a *URLScreenshotter) interceptRequests(ctx context.Context) {
+	chromedp.ListenTarget(ctx, func(ev interface{}) {
+		switch e := ev.(type) {
+		case *fetch.EventRequestPaused:
+			go func() {
+				ctx := cdp.WithExecutor(ctx, chromedp.FromContext(ctx).Target)
+				var err error
+				if e.ResponseStatusCode != 0 || e.ResponseErrorReason != "" {
+					err = stopAtRedirect(e).Do(ctx)
+				} else if a.session.InScope(e.Request.URL) {
+					err = a.continueWithAuthorization(e).Do(ctx)
+				} else {
+					err = fetch.FailRequest(e.RequestID, network.ErrorReasonBlockedByClient).Do(ctx)
+				}
+				if err != nil {
+					a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
+				}
+			}()
+		case *fetch.EventAuthRequired:
+			go func() {
+				ctx := cdp.WithExecutor(ctx, chromedp.FromContext(ctx).Target)
+				response := &fetch.AuthChallengeResponse{Response: fetch.AuthChallengeResponseResponseDefault}
+				if e.AuthChallenge.Source == fetch.AuthChallengeSourceProxy {
+					response = &fetch.AuthChallengeResponse{
+						Response: fetch.AuthChallengeResponseResponseProvideCredentials,
+						Username: a.proxyUsername,
+						Password: a.proxyPassword,
+					}
+				}
+				if err := fetch.ContinueWithAuth(e.RequestID, response).Do(ctx); err != nil {
+					a.session.Out.Debug("[%s] Error: %v\n", a.ID(), err)
+				}
+			}()
+		}
+	})
+}
+
+// stopAtRedirect makes the browser show a redirect response of a document
+// like any other response instead of following it, as the requester does
+// when redirects are not followed
+func stopAtRedirect(e *fetch.EventRequestPaused) chromedp.Action {
+	if e.ResponseStatusCode < 300 || e.ResponseStatusCode >= 400 {
+		return fetch.ContinueRequest(e.RequestID)
+	}
+	var headers []*fetch.HeaderEntry
+	for _, header := range e.ResponseHeaders {
+		if !strings.EqualFold(header.Name, "Location") {
+			headers = append(headers, header)
+		}
+	}
+	// The original body is kept when none is given
+	return fetch.FulfillRequest(e.RequestID, e.ResponseStatusCode).WithResponseHeaders(headers).WithResponsePhrase(e.ResponseStatusText)
+}
+
+// continueWithAuthorization continues the paused request, adding the
+// Authorization header if an auth rule matches its host
+func (a *URLScreenshotter) continueWithAuthorization(e *fetch.EventRequestPaused) *fetch.ContinueRequestParams {
+	action := fetch.ContinueRequest(e.RequestID)
+	u, err := url.Parse(e.Request.URL)
+	if err != nil {
+		return action
+	}
+	authorization := a.session.Credentials.Authorization(u.Hostname())
+	if authorization == "" {
+		return action
+	}
+	headers := []*fetch.HeaderEntry{{Name: "Authorization", Value: authorization}}
+	for name, value := range e.Request.Headers {
+		if !strings.EqualFold(name, "Authorization") {
+			headers = append(headers, &fetch.HeaderEntry{Name: name, Value: fmt.Sprint(value)})
+		}
+	}
+	return action.WithHeaders(headers)
+}
+
+// hostResolverAddress brackets IPv6 addresses, which Chrome requires
+// in host resolver rules
+func hostResolverAddress(address string) string {
+	if strings.Contains(address, ":") {
+		return "[" + address + "]"
+	}
+	return address
+}
+
+// setCookies makes the browser send the cookies loaded from cookie files
+func setCookies(cookies []core.Cookie) chromedp.Tasks {
+	tasks := chromedp.Tasks{}
+	for _, cookie := range cookies {
+		action := network.SetCookie(cookie.Name, cookie.Value).WithURL(cookie.URL().String()).WithPath(cookie.Path).WithSecure(cookie.Secure)
+		if cookie.Subdomains {
+			action = action.WithDomain("." + cookie.Domain)
+		}
+		tasks = append(tasks, action)
+	}
+	return tasks
+}
+
+// setRequestHeaders makes the browser send recorded headers, cookies
+// are set for the page URL only so they don't leak to other sites
+func setRequestHeaders(urlstr string, headers map[string]string) chromedp.Tasks {
+	tasks := chromedp.Tasks{}
+	extraHeaders := network.Headers{}
+	for name, value := range headers {
+		if !strings.EqualFold(name, "Cookie") {
+			extraHeaders[name] = value
+			continue
+		}
+		for _, cookie := range strings.Split(value, ";") {
+			nameValue := strings.SplitN(strings.TrimSpace(cookie), "=", 2)
+			if len(nameValue) != 2 {
+				continue
+			}
+			tasks = append(tasks, network.SetCookie(nameValue[0], nameValue[1]).WithURL(urlstr))
+		}
+	}
+	if len(extraHeaders) > 0 {
+		tasks = append(tasks, network.SetExtraHTTPHeaders(extraHeaders))
+	}
+	return tasks
+}
+
 func takeScreenshot(urlstr string, res *[]byte) chromedp.Tasks {
 	return chromedp.Tasks{
 		chromedp.Navigate(urlstr),
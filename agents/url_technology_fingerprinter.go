@@ -1,8 +1,6 @@
 package agents
 
 import (
-	"crypto/tls"
-	"io"
 	"net/http"
 	"strings"
 
@@ -12,8 +10,8 @@ import (
 
 // URLTechnologyFingerprinter structure
 type URLTechnologyFingerprinter struct {
-	session      *core.Session
-	technologies map[string]struct{}
+	session *core.Session
+	client  *http.Client
 }
 
 // ID returns name of the source file
@@ -28,10 +26,9 @@ func NewURLTechnologyFingerprinter() *URLTechnologyFingerprinter {
 
 // Register is registering for EventBus URLResponsive events
 func (a *URLTechnologyFingerprinter) Register(s *core.Session) error {
-	s.EventBus.SubscribeAsync(core.URLResponsive, a.OnURLResponsive, false)
 	a.session = s
-
-	return nil
+	a.client = MakeClient(s)
+	return s.Subscribe(core.URLResponsive, a.OnURLResponsive)
 }
 
 // OnURLResponsive makes request and takes fingerprints
@@ -42,22 +39,22 @@ func (a *URLTechnologyFingerprinter) OnURLResponsive(url string) {
 		a.session.Out.Error("[%s] Unable to find page for URL: %s\n", a.ID(), url)
 		return
 	}
-	a.session.WaitGroup.Add()
-	go func(page *core.Page) {
-		defer a.session.WaitGroup.Done()
-		seen := make(map[string]struct{})
-		a.fingerprint(page)
-		for key := range a.technologies {
-			if _, ok := seen[key]; ok {
-				continue
-			}
-			seen[key] = struct{}{}
-			page.AddTag(key, "info", a.constructURL(key))
+	if err := a.session.Pool(core.FingerprintPool).AddWithContext(a.session.Context()); err != nil {
+		return
+	}
+	defer a.session.Pool(core.FingerprintPool).Done()
+	seen := make(map[string]struct{})
+	technologies := a.fingerprint(page)
+	for key := range technologies {
+		if _, ok := seen[key]; ok {
+			continue
 		}
-	}(page)
+		seen[key] = struct{}{}
+		page.AddTag(key, "info", a.constructURL(key))
+	}
 }
 
-func (a *URLTechnologyFingerprinter) fingerprint(page *core.Page) {
+func (a *URLTechnologyFingerprinter) fingerprint(page *core.Page) (technologies map[string]struct{}) {
 	var body []byte
 	var headers http.Header
 	var err error
@@ -73,21 +70,33 @@ func (a *URLTechnologyFingerprinter) fingerprint(page *core.Page) {
 			return
 		}
 	} else {
-		// Ignore Certificate Errors
-		http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
-		resp, err := http.DefaultClient.Get(page.URL)
+		if !a.session.InScope(page.URL) {
+			return
+		}
+		req, err := http.NewRequestWithContext(a.session.Context(), "GET", page.URL, nil)
 		if err != nil {
 			a.session.Out.Error("[%s]: %s\n", a.ID(), err.Error())
 			return
 		}
-		body, err = io.ReadAll(resp.Body)
+		SetRequestHeaders(a.session, req)
+		if err := a.session.Throttle(req.URL.Hostname()); err != nil {
+			return
+		}
+		resp, err := a.client.Do(req)
 		if err != nil {
 			a.session.Out.Error("[%s]: %s\n", a.ID(), err.Error())
 			return
 		}
 		defer resp.Body.Close()
+		body, err = ReadBody(a.session, resp.Body)
+		if err != nil {
+			a.session.Out.Error("[%s]: %s\n", a.ID(), err.Error())
+			return
+		}
 		headers = resp.Header
+		page.Lock()
 		page.PageTitle = ExtractTitle(body)
+		page.Unlock()
 	}
 
 	wappalyzerClient, err := wappalyzer.New()
@@ -95,8 +104,9 @@ func (a *URLTechnologyFingerprinter) fingerprint(page *core.Page) {
 		a.session.Out.Error("[%s]: %s\n", a.ID(), err.Error())
 		return
 	}
-	a.technologies = wappalyzerClient.Fingerprint(headers, body)
-	a.session.Out.Debug("[%s] Identified technology %s on %s\n", a.ID(), a.technologies, page.URL)
+	technologies = wappalyzerClient.Fingerprint(headers, body)
+	a.session.Out.Debug("[%s] Identified technology %s on %s\n", a.ID(), technologies, page.URL)
+	return technologies
 }
 
 func (a *URLTechnologyFingerprinter) getHeaders(headersPath string) (headers http.Header) {
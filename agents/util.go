@@ -2,14 +2,17 @@ package agents
 
 import (
 	"crypto/sha1"
-	"crypto/tls"
+	"errors"
 	"fmt"
 	"io"
 	"math/rand"
+	"net"
 	"net/http"
+	"net/textproto"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/VasilyKaiser/aquasily/core"
@@ -92,28 +95,195 @@ func RandomIPv4Address() string {
 	return strings.Join(blocks, ".")
 }
 
+// Header spoofing profiles
+const (
+	SpoofNone   = "none"
+	SpoofRandom = "random"
+	SpoofFixed  = "fixed"
+	SpoofCycle  = "cycle"
+)
+
+// HeaderSpoofer builds the spoofed X-Forwarded-For, Via and Forwarded
+// headers of a profile: none, random addresses, a fixed address, a fixed
+// address per header or a list of addresses used in turn
+type HeaderSpoofer struct {
+	Profile   string
+	addresses []string
+	fixed     map[string]string
+	next      uint64
+}
+
+// spoofedHeaders are the headers a spoofer sends
+var spoofedHeaders = []string{"X-Forwarded-For", "Via", "Forwarded"}
+
+// NewHeaderSpoofer returns the spoofer for a "none", "random",
+// "fixed:<address>", "fixed:<header>=<address>,..." or
+// "cycle:<address>,<address>,..." profile
+func NewHeaderSpoofer(definition string) (*HeaderSpoofer, error) {
+	profile, list, _ := strings.Cut(definition, ":")
+	h := &HeaderSpoofer{Profile: profile}
+	if profile == SpoofFixed && strings.Contains(list, "=") {
+		return h, h.parseFixedHeaders(list)
+	}
+	for _, address := range strings.Split(list, ",") {
+		if address = strings.TrimSpace(address); address == "" {
+			continue
+		}
+		if net.ParseIP(address) == nil {
+			return nil, fmt.Errorf("invalid spoofed address %q, expected an IP address", address)
+		}
+		h.addresses = append(h.addresses, address)
+	}
+	switch profile {
+	case "", SpoofNone, SpoofRandom:
+		if len(h.addresses) > 0 {
+			return nil, fmt.Errorf("header spoofing profile %s takes no addresses", profile)
+		}
+	case SpoofFixed:
+		if len(h.addresses) != 1 {
+			return nil, errors.New("header spoofing profile fixed takes one address, e.g. fixed:10.0.0.1, or one per header, e.g. fixed:x-forwarded-for=10.0.0.1,via=10.0.0.2")
+		}
+	case SpoofCycle:
+		if len(h.addresses) == 0 {
+			return nil, errors.New("header spoofing profile cycle takes a list of addresses, e.g. cycle:10.0.0.1,10.0.0.2")
+		}
+	default:
+		return nil, fmt.Errorf("unknown header spoofing profile %q, expected none, random, fixed or cycle", profile)
+	}
+	return h, nil
+}
+
+// parseFixedHeaders reads the "<header>=<address>" list of the fixed
+// profile, only the listed headers are sent
+func (h *HeaderSpoofer) parseFixedHeaders(list string) error {
+	h.fixed = make(map[string]string)
+	for _, definition := range strings.Split(list, ",") {
+		name, address, _ := strings.Cut(definition, "=")
+		name = textproto.CanonicalMIMEHeaderKey(strings.TrimSpace(name))
+		address = strings.TrimSpace(address)
+		if !isSpoofedHeader(name) {
+			return fmt.Errorf("invalid spoofed header %q, expected X-Forwarded-For, Via or Forwarded", name)
+		}
+		if net.ParseIP(address) == nil {
+			return fmt.Errorf("invalid spoofed address %q for %s, expected an IP address", address, name)
+		}
+		h.fixed[name] = address
+	}
+	return nil
+}
+
+func isSpoofedHeader(name string) bool {
+	for _, header := range spoofedHeaders {
+		if name == header {
+			return true
+		}
+	}
+	return false
+}
+
+// Headers returns the spoofed headers for a request with the scheme,
+// or none if spoofing is disabled
+func (h *HeaderSpoofer) Headers(scheme string) map[string]string {
+	var client, proxy string
+	switch h.Profile {
+	case SpoofRandom:
+		client, proxy = RandomIPv4Address(), RandomIPv4Address()
+	case SpoofFixed, SpoofCycle:
+		if h.fixed != nil {
+			headers := make(map[string]string)
+			for name, address := range h.fixed {
+				headers[name] = spoofedHeader(name, scheme, address, address)
+			}
+			return headers
+		}
+		i := atomic.AddUint64(&h.next, 1) - 1
+		client = h.addresses[i%uint64(len(h.addresses))]
+		proxy = client
+	default:
+		return nil
+	}
+	headers := make(map[string]string)
+	for _, name := range spoofedHeaders {
+		headers[name] = spoofedHeader(name, scheme, client, proxy)
+	}
+	return headers
+}
+
+// spoofedHeader returns the value of a spoofed header for a request
+// from the client address through the proxy address
+func spoofedHeader(name string, scheme string, client string, proxy string) string {
+	switch name {
+	case "Via":
+		return fmt.Sprintf("1.1 %s", proxy)
+	case "Forwarded":
+		return fmt.Sprintf("for=%s;proto=%s;by=%s", forwardedNode(client), scheme, forwardedNode(proxy))
+	}
+	return client
+}
+
+// forwardedNode quotes IPv6 addresses as required by the Forwarded header
+func forwardedNode(address string) string {
+	if strings.Contains(address, ":") {
+		return fmt.Sprintf("\"[%s]\"", address)
+	}
+	return address
+}
+
 // URLEscape escapes the string so it can be safely placed inside a URL query
 func URLEscape(s string) string {
 	return url.QueryEscape(s)
 }
 
-// MakeClient returns a new client struct
-func MakeClient(o core.Options) *http.Client {
-	if *o.Proxy != "" {
-		proxyURL, err := url.Parse(*o.Proxy)
-		if err != nil {
-			return &http.Client{Timeout: time.Duration(*o.HTTPTimeout) * time.Millisecond}
+// MakeClient returns a client sending requests with the shared transport of the session
+func MakeClient(s *core.Session) *http.Client {
+	return &http.Client{
+		Timeout:       time.Duration(*s.Options.HTTPTimeout) * time.Millisecond,
+		Transport:     s.Transport,
+		CheckRedirect: CheckRedirectInScope(s),
+	}
+}
+
+// ReadBody reads the response body up to the maximum body size
+func ReadBody(s *core.Session, body io.Reader) ([]byte, error) {
+	if *s.Options.MaxBodySize > 0 {
+		body = io.LimitReader(body, int64(*s.Options.MaxBodySize)<<20)
+	}
+	return io.ReadAll(body)
+}
+
+// CheckRedirectInScope returns a redirect policy which keeps the redirect
+// response instead of following it when redirects are disabled or its
+// location is out of scope
+func CheckRedirectInScope(s *core.Session) func(req *http.Request, via []*http.Request) error {
+	return func(req *http.Request, via []*http.Request) error {
+		if !*s.Options.FollowRedirects {
+			return http.ErrUseLastResponse
+		}
+		if len(via) >= 10 {
+			return errors.New("stopped after 10 redirects")
 		}
-		return &http.Client{
-			Timeout: time.Duration(*o.HTTPTimeout) * time.Millisecond,
-			Transport: &http.Transport{
-				Proxy:           http.ProxyURL(proxyURL),
-				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
-			},
+		if !s.InScope(req.URL.String()) {
+			return http.ErrUseLastResponse
 		}
+		return nil
+	}
+}
 
+// SetRequestHeaders adds request headers recorded for the target of the
+// request URL, followed by the custom headers, cookies and authentication
+// which apply to the URL. Cookies are added to the recorded ones
+func SetRequestHeaders(s *core.Session, req *http.Request) {
+	if target := s.GetTarget(req.URL.String()); target != nil {
+		for name, value := range target.Headers {
+			req.Header.Set(name, value)
+		}
+	}
+	for name, value := range s.Credentials.RequestHeaders(req.URL) {
+		if name == "Cookie" && req.Header.Get("Cookie") != "" {
+			value = req.Header.Get("Cookie") + "; " + value
+		}
+		req.Header.Set(name, value)
 	}
-	return &http.Client{Timeout: time.Duration(*o.HTTPTimeout) * time.Millisecond}
 }
 
 // BaseFilenameFromURL returns a filename made up from URL
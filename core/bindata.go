@@ -86,7 +86,7 @@ func staticReportTemplateHTML() (*asset, error) {
 		return nil, err
 	}
 
-	info := binDataFileInfo{name: "static/report_template.html", size: 31308, mode: os.FileMode(0644), modTime: time.Unix(1558255764, 0)}
+	info := binDataFileInfo{name: "static/report_template.html", size: 38797, mode: os.FileMode(0644), modTime: time.Unix(1792312150, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -98,4 +98,4 @@ func staticReportTemplateHTMLBytes() ([]byte, error) {
 	)
 }
 
-var _staticReportTemplateHTML = []byte("\x1f\x8b\x08\x08\x80\xe3\xe8\x0d\x00\xff\x73\x74\x61\x74\x69\x63\x2f\x72\x65\x70\x6f\x72\x74\x5f\x74\x65\x6d\x70\x6c\x61\x74\x65\x2e\x68\x74\x6d\x6c\x00\xec\xbd\x77\x93\xf2\x46\xb6\x30\xfe\xff\x56\xed\x77\xd0\x62\xfb\x32\xcf\x65\x40\x12\x22\x7b\x66\x6a\xc9\x39\x67\xfc\xee\xcf\xdb\x92\x5a\x01\x24\xb5\x90\x5a\x12\xe0\xf2\x77\xff\x95\x02\x0c\x19\xe6\xb1\x7d\xef\xd6\x5b\xaf\xec\x67\x80\x0e\x27\x75\x9f\xd3\xe7\x74\xd2\xdb\x3f\x78\xc4\xe1\xad\x0e\x09\x09\xab\xca\xc7\xdf\xff\xf6\xe6\x7e\x12\x0a\xd0\xc4\xf7\x10\xd4\x42\x1f\x7f\xff\x9b\x9b\x06\x01\xff\xf1\xf7\xbf\x11\x04\x41\xbc\xa9\x10\x03\x82\x93\x80\x61\x42\xfc\x1e\xb2\xb0\x10\xcd\x84\x4e\xf2\x34\xa0\xc2\xf7\x90\x2d\x43\x47\x47\x06\x0e\x11\x1c\xd2\x30\xd4\xf0\x7b\xc8\x91\x79\x2c\xbd\xf3\xd0\x96\x39\x18\xf5\x7e\xbc\x12\xb2\x26\x63\x19\x28\x51\x93\x03\x0a\x7c\xa7\x5f\x09\x53\x32\x64\x6d\x15\xc5\x28\x2a\xc8\xf8\x5d\x43\xd7\x60\xf3\xd0\xe4\x0c\x59\xc7\x32\xd2\x8e\xc0\xe7\xd7\x16\x30\x65\x65\x4b\x0c\xa0\x87\xf8\x4a\x45\x60\x61\x09\x19\x47\x75\x26\x7e\x8d\x26\x90\x4d\x68\x10\x2f\x12\xc6\xba\x99\x23\x49\xec\xc8\x18\x1a\x31\x0e\xa9\xa4\xed\x95\xf0\x0b\x7c\xbb\x06\x53\x84\x1a\x34\x00\x3e\x01\x7b\x20\xc5\xa6\x63\x54\x8c\xbe\x56\xcd\x40\x2c\xc2\xe6\x51\x1d\x0d\xc9\x1a\x0f\x37\xaf\x84\x86\x04\xa4\x28\xc8\x39\xd4\xc2\x32\x56\xe0\xc7\x19\x7b\x6f\xa4\x9f\x1c\x94\x51\x64\x6d\x45\x18\x50\x79\x0f\x99\x78\xab\x40\x53\x82\x10\x87\x08\xc9\x80\xc2\x7b\x68\xcf\x95\x89\x01\xb7\xd2\x01\x96\x62\x2c\x42\xd8\xc4\x06\xd0\x39\x5e\xf3\xb8\x3c\x24\x90\x89\x18\x13\xa3\x49\xce\x34\x3f\xd3\x62\xaa\xac\xc5\x38\xd3\x0c\xf9\xb8\xdc\x47\xd6\x30\x14\x0d\x19\x6f\xdf\x43\xa6\x04\x98\x4c\x22\x2a\x8a\xdd\xed\x80\x92\x67\x45\xb6\xdd\xb7\x99\x99\xac\xab\x80\x49\xb4\x4b\x11\xbe\x46\xd2\x42\x3f\x9d\x49\x90\xcb\x14\x37\x27\xe5\xc6\xa8\x3f\xee\x4a\xdc\xd4\x48\x6f\xb2\x0d\x1b\x0d\x36\xa3\x78\x7b\xe1\xd0\xa3\x10\xc1\x19\xc8\x34\x91\x21\x8b\xb2\xf6\x1e\x02\x1a\xd2\xb6\x2a\xb2\xcc\xd0\x57\x38\x74\xd9\x59\x9a\x3c\x54\x64\xdb\x88\x69\x10\x93\x9a\xae\x92\xb6\x6c\x2e\xcd\xa8\x06\xb1\x83\x8c\xd5\x3f\x13\xb1\x78\x22\x96\x26\x79\xd9\xc4\x6e\xce\x53\xbc\x49\x76\x6a\x38\xca\x57\xad\x55\x62\x3d\x72\x54\x63\x5b\x61\x17\x8b\x91\xc6\xf4\x8d\xea\x60\xbb\x98\xd2\x26\x2a\x66\x9b\x64\x69\x9b\xca\xec\xcc\x8c\x69\xb1\x85\x4a\x77\x9c\xca\x62\x91\xac\x56\x17\xc2\xaa\x5e\x60\x1f\xf2\xe6\x71\x44\xb8\x6a\xf8\x1e\xc2\x70\x83\x5d\xf9\xef\x33\xdd\x47\x40\x08\x43\x83\xf8\xed\x33\xc5\x7d\x58\x64\xf0\xd0\x88\x62\xa4\xe7\x08\x5a\xdf\x10\x26\x52\x64\x9e\x30\x44\x16\xbc\x50\xaf\x84\xff\x7f\x8c\x8e\x27\xbf\xfd\x7c\x5a\x4f\x05\x86\x28\x6b\x7e\xbd\x24\xa5\x6f\xce\xb2\x75\xc0\xf3\xb2\x26\x5e\xcd\x73\x89\x8b\x02\x45\x16\xb5\x1c\xc1\x41\x0d\x43\xe3\xac\x80\x80\x34\x1c\x35\xe5\x1d\xcc\x11\x74\xfc\xa2\x3a\x87\x14\x64\xe4\x5c\x12\x5f\x52\x99\x57\xc2\xff\x77\x4c\xde\xef\xfe\xd7\x0b\xce\xc1\x39\xef\x01\x24\x59\x93\xa0\x21\x63\xe2\x1f\xb2\xea\x2a\x05\xd0\xf0\x35\x82\x79\xc8\x21\x03\xb8\xe6\x22\x47\x58\x1a\x0f\x0d\x45\xd6\xe0\x3d\xac\x31\x0e\x18\xc8\x32\xa1\x72\x8e\x36\x10\x1d\x8b\x30\x46\xea\x85\x84\x6e\xc3\x89\xca\x18\xaa\x37\x78\xf8\x81\xc9\x30\x7c\x82\xfe\x8a\xa4\x1f\x21\x8a\xe9\x40\x84\x51\x0e\x18\xfc\x39\x4e\xcf\xec\xe6\x08\x86\xba\xdf\xb6\x0a\x14\xce\x25\xe9\xb3\x9e\x23\xe2\x49\x7d\x43\xd0\x94\xbe\x21\x92\xfb\x6f\x67\x25\x79\xd9\xd4\x15\xb0\x75\x5b\xc7\x15\x74\x94\x55\x10\xb7\xba\x4b\xbd\x29\x6b\xa2\x02\xa3\x3e\xd5\x48\xc3\x40\xd6\xa0\x71\xc4\xc5\xeb\x93\x65\xdd\x71\x0a\x1a\x66\x14\x03\x56\x81\xd7\xdb\x2e\x47\xb8\xa4\x7b\xe4\x07\x5f\xee\x52\xe6\x81\x35\x39\x03\x42\xcd\x94\x10\x3e\xc2\x78\x06\x5d\x47\xa6\xec\xf7\x30\x03\x2a\x00\xcb\x36\x3c\x93\x0a\xb2\xa1\x21\x28\xc8\xc9\x11\x92\xcc\xf3\x50\xfb\xf9\xaa\x32\xef\x7b\xd6\xb3\xfa\xfc\x90\xe2\x73\x3a\xb1\x01\xb4\x3d\xa5\xde\x77\x01\x19\x2a\x11\x4b\x9a\x04\x04\x26\x8c\x22\xeb\xbc\xdd\x39\xcb\x30\xdd\x6e\xba\x43\x48\x8d\xca\xe7\x64\x07\xfd\x89\xa6\xa8\x9f\x9e\xeb\x9f\xae\x00\x0d\xa4\x44\x75\x03\xda\xaf\xf7\x0a\x68\x70\x73\x41\x7c\x80\x2d\xf9\x1d\xb8\xa2\x32\x87\xb4\x0b\x0b\x0a\xb8\x95\x68\x20\x4b\xe3\xa3\xb2\x0a\x44\x98\x23\x2c\x43\x79\x09\xf1\x00\x83\x9c\x97\x40\x9a\xb6\x18\xd9\xa8\xca\xeb\x4f\x0c\x67\xda\x22\xb1\x51\x15\xcd\x7c\x0f\xbb\xe3\x4d\x8e\x24\x1d\xc7\x89\x39\x4c\x0c\x19\x22\x19\xa7\x28\xca\x2d\x1c\x26\x04\x59\x51\xde\xc3\x3f\xc5\x99\x14\x97\x4e\xa6\xf9\x30\xe1\x7a\x42\x05\xb4\x79\x0f\x53\x04\x45\x64\x88\x4c\xf8\x27\x06\xfe\xc4\x70\xee\x40\x4c\xf0\xef\xe1\x76\x32\x16\x4f\x12\x94\x12\x4d\x10\xfe\x7f\x74\x2c\x19\x75\xff\xc5\xfd\x7f\x44\xf0\x19\x0d\xd2\x77\x61\xd2\x07\xe0\xa2\xfb\x89\x81\xa1\xfb\xdd\xe1\xaa\x5c\xff\x73\x85\x11\x8f\xa5\x3d\x61\xd0\xb1\xa4\x2b\x08\xe2\x48\x00\xde\xf7\x7d\x7a\x22\xea\xfd\xf7\xbd\xc2\x90\x35\x5e\xe6\x5c\xa7\xcd\x24\x14\xf9\x8e\x20\xf6\x26\xda\x27\xff\x2e\x6c\x16\xf0\xe2\x0d\x9b\x13\x35\x64\x51\xc2\x39\x22\xf9\x8c\xb1\xb9\x6b\xc3\x9e\x55\xb7\x2b\x90\xf0\xc5\x58\xe0\x8d\xd5\x02\x50\x65\x65\x9b\x23\xf2\x7b\xa7\x84\xe8\x19\xe8\x95\x28\x22\xcd\x44\x0a\x30\x5f\x89\x36\xd4\x14\xf4\x4a\xb4\x91\x06\x38\xf4\x4a\xb4\x2c\x4e\xe6\x41\x90\x0f\x5f\x89\x96\xcc\x42\x7f\x6c\x75\x8b\xa0\x57\xa2\x04\x97\x60\x62\x11\x43\xa0\x99\x41\x4a\x41\x76\xfd\x48\x08\x54\x62\x02\x0d\x70\x9c\x53\x44\x96\x21\x43\x83\xe8\x40\xe7\x95\x50\x91\x86\x4c\x1d\x70\xf0\x95\x30\xa1\x21\x0b\x5f\x67\x30\xe6\x27\x44\x6d\xa0\x58\x97\xa2\x43\x06\x1f\x65\x0d\x08\x56\x39\xc2\xfb\x88\x02\x45\xf9\x8e\x51\xe9\xba\x13\xf6\x55\xbb\x4d\x7c\x87\x33\x21\x1a\x40\x97\xfe\xc0\x08\x74\xbd\xf3\xb8\x8f\x04\xfd\x0e\x9a\xbe\xe2\x16\x1c\x7b\x8b\xf1\xcb\x6c\x9f\xff\x3f\x32\x60\x79\x6c\xdd\x66\x06\xb0\x26\x52\x2c\x7c\xce\x8c\x47\x0f\x75\x96\xe8\xba\x2d\x97\xa9\x8f\xf9\x7e\xa8\x4f\xbe\xe8\x15\x04\x5c\xcf\x38\xea\x0e\xe5\x0a\xd8\xfe\x47\xd1\xec\x3e\xbb\xa8\x17\x42\xe6\x88\x6c\x36\x9b\x3d\x6f\xa7\x4b\xa3\x26\x78\xcf\x57\x3d\xfc\x20\x3c\x08\x3a\xc4\x83\xa1\xf8\xba\xdc\x62\xba\x81\x44\x03\x9a\xe6\x0d\x73\xe9\x8b\x04\x58\x18\x5d\xef\x8b\x81\x39\xbd\x52\x60\xef\x20\xdc\x94\x1a\xf3\xc8\x06\x9b\x12\x72\xa2\x2a\x32\x60\x94\xb5\x30\xbe\x1c\x25\x1f\x04\x4f\x0f\x75\xfa\x78\xc4\xc0\x5a\x54\x37\x64\x15\x18\xdb\x18\x2f\x9b\xae\x11\xe3\x5f\x4f\x92\x73\xfb\xe4\xc7\x43\x94\x68\x80\xed\x3d\xc6\x7e\xf8\x74\x09\xdb\x88\x07\xca\x43\x47\xf1\x76\x0f\xdc\x7b\x84\x3a\x92\xaf\xc7\x25\x6f\xa4\x17\xd0\x7e\xfc\xfd\x6f\x6f\x64\x30\x75\xf4\xf7\xbf\xbd\xb1\x88\xdf\xee\x03\x5e\x0d\xd8\x04\xa7\x00\xd3\x7c\x0f\x69\xc0\x66\x81\x41\xf8\x1f\x51\xb8\xd1\x81\xc6\x47\x55\x7e\x9f\xc0\x03\x63\x45\xb0\xa2\xf7\x79\x1c\x12\xbf\x81\x53\x00\x51\xd6\x00\x1a\xbf\x9f\x10\xf8\x21\xf4\x91\xef\x8f\xf3\xc3\x7a\x6b\xfe\x46\x82\xe3\x6a\x41\xab\x9e\xd6\xc5\x48\x14\x15\x68\x84\x82\x10\xdc\x2f\x13\x22\x5c\xd7\x27\xc8\x7b\x0f\x71\x48\x51\x80\x6e\xc2\x7d\x32\x30\x44\x88\xdf\x43\x3f\xf8\x20\xda\x50\xb3\x42\xa7\x62\x02\x86\x0c\xf6\x5e\x97\x79\x5a\xd0\xcf\xf3\x79\x85\xfc\x7b\x48\x00\x8a\x0b\xd8\x4b\x55\x00\x0b\x95\xf7\xd0\xc8\x43\xeb\x4a\x41\x16\xbd\xe1\xf5\x98\x79\x8f\x13\x53\x07\x37\xf8\xf0\xdc\xbb\xd0\xc7\x1b\xe9\x16\x39\x66\x9e\xf4\x39\xfb\x38\xee\x86\x6f\xbc\x7c\x68\x8b\x3d\x8f\x7b\xe1\x7f\xf2\x2c\xf3\x7b\x24\x1e\x03\xe7\xb4\x58\xca\x19\x25\x6e\x03\xab\x46\xd4\x55\xd1\xf3\xc2\x84\x3f\x9b\x73\x54\xc1\x0f\x5a\x79\x03\xe9\x3c\x72\x2e\x18\xbd\xda\xe2\x51\x6f\x3a\x68\x5f\x25\x60\xfc\xb3\xf5\x3d\x7a\xdd\x0e\x6e\x96\xf6\x50\x09\x03\x29\xb7\xda\xf6\x80\xfa\x3a\xe6\x43\x6b\x4a\xc0\xd4\x91\x6e\xe9\xef\x21\x6c\x58\xf0\x46\x33\xde\x20\xdf\x7d\x7a\x2e\x45\x37\xb8\x3b\xe9\xa6\x27\x39\x47\x0d\x74\x60\x58\xfd\xec\x46\x5e\x87\x51\x20\xcf\x6e\xcf\x59\xbe\x43\xc9\xa7\x30\x0f\x20\xdd\x46\x38\x48\x90\xf4\x20\x91\xec\x36\x6a\xca\xaa\xac\x00\x43\xc6\xdb\xd0\x47\x61\x4b\x0c\x0f\x3f\x6f\x53\xfc\x35\x04\x12\x32\xb1\xe9\xc1\xae\xb9\xdf\xfe\x14\xb0\xbe\xeb\x16\xfa\x18\x7a\x9f\xbe\xdc\xef\x48\x98\xe4\x65\xfb\x5a\x2f\x25\x15\xf9\xb9\xce\xfb\x7c\x9f\x3d\xa7\xd4\x1b\x21\x43\x1f\x55\xf7\xe3\x3a\x85\x57\xa8\x78\x23\x2d\xe5\x44\xaf\x3f\xe9\x7f\x23\x35\x60\x1f\x14\xfc\x4d\x05\xb2\x16\xf4\x7c\xf7\x6b\xe8\x53\xd3\x03\x2f\xd2\x57\x15\xa0\xeb\x27\xc6\xd5\x40\x16\x76\x1d\x69\x19\x3a\x1f\x6f\xe4\xf1\xaf\x3d\x16\x17\xda\x27\x9a\x60\x9a\xce\x05\xe5\x7f\x3d\x81\xa6\xef\xb1\x7a\xce\x85\x6a\x61\xc8\x7f\xda\xe7\x60\x8e\x9c\xf8\x2f\x55\xe6\x79\x84\x7f\x26\x54\xc0\x43\xc2\x91\xb1\xe4\x9b\xb8\x4b\x81\x78\x83\x8b\x67\xac\x90\x91\x33\x20\xff\xb3\x17\xc8\x38\xfe\x08\xcf\x22\x85\x0f\x7d\xfc\xd7\x0f\x34\x45\x65\x12\x3f\x07\x26\x90\x60\xb7\x6e\x53\x9c\x4e\x16\x63\x47\xc6\xd7\x66\xf9\xaf\x18\x81\xbd\xb1\xff\x95\x55\x80\xb6\x0a\x7d\xf8\xcb\x06\x97\xe5\x7c\x00\x6e\x33\xbe\x91\xfa\x89\x04\x3e\x2e\xd0\xbb\xf1\x32\x6b\x6d\x55\x08\x38\x24\x08\x10\x7a\x74\xa8\x32\x27\x41\xcd\x90\x57\x26\xd4\x42\x17\x68\xdf\x64\x55\xbc\xde\xcf\x4c\x83\x7b\x3f\x8e\xd4\x75\x4d\xfc\x99\x05\x26\x4c\x25\x5e\xe5\x49\xa1\x3b\x70\xa8\x66\x55\x44\xf9\x7c\x3e\xdf\x19\x8e\xa5\xf2\x58\xcc\xe7\xf3\x4d\xef\xb7\x52\xcc\xcf\xf3\xf9\x7c\x69\xb8\xaa\x35\x7b\x6e\x42\x75\x36\xa8\x4c\x6b\x83\x11\x1b\x5f\x50\x7c\xbc\xb2\x5d\xf4\x0b\x85\x45\x35\x2b\x2f\x86\x85\x06\x3b\xad\x68\x8b\x49\x43\x99\x4f\x07\x49\x8e\x53\x14\xb7\x42\xb1\x5b\x68\x0c\xca\x95\x31\xec\x18\xe6\xac\x9d\xed\x4d\xca\x1c\xa7\xd1\xd4\xa4\x51\x8d\x4f\x36\xa5\x11\x1e\x8e\x84\xb2\x5e\xe7\xab\x53\x98\xac\x26\xf8\x26\xd5\x20\xcb\xc2\xba\x53\x9a\xb7\x23\x4d\x1a\x70\x45\x32\x5f\xde\xda\x8d\x75\xb1\x96\x55\xeb\x45\x0d\xeb\xa5\x55\x66\xe2\x00\x4d\x17\x97\x14\xdd\xce\xa7\xe6\xf1\xde\x5c\xad\xeb\xa6\xd9\x6c\xeb\x4c\xcf\xe9\x0a\x1b\x66\x5a\x83\x71\x12\xc6\xad\x0c\x36\xd4\x71\x66\x3b\x9d\xb1\x90\xec\x2d\xbb\x7c\x3a\xbd\x23\x47\xd3\x5e\x6b\x28\xf6\x70\x07\x2c\x93\xeb\xae\x99\x17\x9b\xdd\x02\x9e\x14\x11\x9b\x47\x4d\x67\xdd\x15\xf3\x29\x76\xb9\x53\x46\x43\x54\x99\xe5\xc7\xb0\xdd\x99\xf4\xaa\x4b\x2e\x6f\x75\xfa\xf2\xba\xcc\x37\x37\xc2\xb0\xdc\x29\xb6\xc5\x51\xbd\xb9\xdb\x15\x40\xa5\xd1\x4c\x94\xb5\xfc\x48\xab\x14\xf3\x13\xba\xb3\x58\xa6\xc5\xd2\x36\x9d\xe7\x66\x59\xa7\xb8\xaa\x83\x71\x11\x8e\x47\xc6\x62\x0b\x97\x91\x38\xdb\xd1\xf0\x7a\x54\x90\xfa\xe6\x8c\xcd\xaf\xea\x99\x6e\x65\xd5\x70\x20\xc9\x43\x6b\x1a\xc7\xcb\xf9\xb8\xc7\x64\x49\x4e\x49\x09\x53\xba\x33\x63\x71\x7c\xc4\xc7\x49\xc1\xed\x02\xa9\xb8\x62\x73\xe4\xc8\x89\x57\x99\xe5\xb2\xdb\x4e\x2d\xc8\x69\x6d\x5c\xa4\xa7\x78\xaa\x8d\x74\x66\x38\x10\x65\x16\xaf\xc6\x2c\x9b\xb5\xf1\x04\x30\x64\xb3\x60\xf6\x2c\x85\x34\x22\x08\x75\xbb\xad\x24\xb2\xa8\x05\x3f\x55\xf4\xe1\x28\x99\xc8\x8c\x39\xbb\xb5\xcd\x82\x71\x8f\xd9\x25\xda\x95\x31\x09\x3a\x54\x9a\x8f\xa4\xd0\x36\xc9\xd9\xd3\x08\x95\xea\x55\x1d\x2a\xd5\x6b\x4b\xfa\x6c\xce\x64\x25\x43\x4c\x3b\x65\xbe\x53\x36\x1d\x12\x52\x05\xa9\x36\x88\x08\x4a\xa2\x53\xca\x6f\x51\x26\x22\xf4\xa6\x99\x4a\x47\xa4\xac\x59\x4b\x59\x31\xf9\x19\x55\x68\xa6\x44\x61\x27\x6b\xf4\x5c\x69\xea\xda\x68\xaa\xec\xcc\x78\x99\xe9\xaf\x8b\x71\x6b\xde\x37\x26\x83\xe1\x24\x95\x85\x2c\xd0\xec\xb4\x95\xb6\x9c\x85\xc0\x0c\xc4\x0c\x95\x12\xf9\xa5\x29\x24\xb0\x2c\xcd\x4c\xb1\x35\x2f\xca\x66\x37\xc1\xd5\xf9\x44\x91\x49\xee\x34\xa6\x6d\xaf\x2b\x98\x9d\xc6\xf5\x34\xa4\xcd\x49\x51\x9c\x4d\xe8\x2c\xd4\x46\xba\x93\x98\x43\x2c\xe1\x75\x79\xb2\x4e\x67\xac\xb5\xdd\xaa\x00\x1b\x15\xc8\xdd\xc2\xea\x67\xc6\xce\x1c\xf0\xab\x4d\x42\xec\xd7\x53\xa5\x72\xa4\x27\x27\x68\x7e\xbd\x44\xa9\xee\xd4\xe4\x46\x1d\x75\x27\x4c\xe2\x1d\x69\xbe\x6a\x2d\x48\x91\xd3\x1a\x43\xd6\x9a\x71\x4c\x67\x57\x62\x1d\xae\x2a\xad\xb7\x76\x09\x58\xf3\x74\xa2\x82\x27\x29\x7b\x4d\xaf\xb1\x8e\x8c\x0a\xc2\xd3\x7c\x77\x67\xa6\xc7\xd3\x61\x8f\xa2\x39\x4b\xa1\x67\x49\x8a\x49\xd0\xd9\xc9\xb8\xda\x9f\xc5\x23\x93\xec\x3c\x52\x35\x53\xab\xda\x50\xe5\xe4\x84\xd5\x92\x98\x8d\xd2\x6b\xe1\x6c\x84\x01\x7d\xab\xb0\x28\xec\x86\xab\x42\x69\x68\x4e\xfa\x06\xdf\x67\x9b\xb3\x51\x3c\xcd\xdb\x69\x08\x17\xed\x38\x3f\x66\xe3\x11\xbb\x37\xd1\x6c\xc6\x88\xb7\xb4\x55\xa7\x4f\x93\xe9\x76\xb7\xb9\x1c\xac\x3b\x33\x2d\xce\x51\x8d\x6a\x9e\x6f\x8f\xa8\x88\x31\x5c\x4f\xe5\x89\xc2\xcf\x50\xb6\x43\xa6\xb3\xa9\x6c\xbd\x4a\xe3\x72\x65\x98\x6c\x6c\x46\x43\x56\x37\xb2\x8a\x38\xa5\xf5\x94\x50\x13\x8c\x64\x84\xe4\x51\xb3\xc5\x39\xe4\x68\x94\x71\xba\x25\x39\x81\x33\x72\xa4\x54\x4b\x2f\x75\xb5\xd6\xb6\x54\x44\x45\x36\x2b\xa7\x33\x9a\x28\x9d\x51\x79\xde\x2d\x95\x37\x14\x57\x1a\xb3\x6a\xc2\xec\xb0\xaa\xc1\xcc\x18\x20\x73\xa4\xc5\x18\x14\x5b\x58\x54\xf9\x4c\xa9\xa3\x2d\xe2\x02\xae\x95\xb5\x8c\x53\x6a\x33\x99\xde\x6c\xa0\x75\x87\x42\x5b\x5a\x56\x67\x95\xbe\x58\x28\x3a\x30\xa5\x30\x2d\x65\xb3\xc6\xc9\x4a\xb5\x63\xf1\xbc\xcd\x18\xbb\x41\x2a\x62\x1b\x71\xa9\xa8\x2d\xd9\x42\x75\x47\xa7\x22\x42\x53\xd1\x16\x2a\x2b\xda\xdd\x65\x13\xa5\x9b\x96\xd0\x24\x87\xca\x34\x32\x4e\x4f\x7b\x99\xfa\x08\x57\xab\xeb\x3c\x1f\x91\x64\xb5\xc3\xf7\x59\x2e\x4e\x1a\x4b\x3e\xbb\xb6\x37\xb8\x03\xd2\x91\xa5\xb6\x2c\x00\x26\x3b\x5f\x94\xa6\xbb\x9a\x33\xe3\xc6\x95\x54\x41\x9b\x4f\x6b\x85\xee\x8e\x4c\xcd\xd5\xd4\x72\x37\xa5\xd2\xcb\x3a\x2f\x33\xc5\x62\xd6\x34\xea\xc3\xde\x94\xcb\x46\xba\xcd\xee\x6e\xca\xa1\x6a\x91\xd7\x0d\x38\x17\x07\x6a\x7c\xd3\x31\x46\xb5\x5e\x59\xc9\x5a\xe5\xf4\xb6\x38\xea\x0f\x12\x75\x6b\x55\x72\x66\x78\x3b\x23\xa7\x5b\x81\xc9\x6b\x4d\xb1\xd4\x1a\x2b\x3b\xb1\x0f\xb9\x2d\x2d\x27\xa4\xa5\x26\x47\x1a\x6a\x19\xcb\x42\xc6\x19\x49\x8d\x49\xd1\x54\x0c\x50\x18\xe6\xdb\x65\x91\xcc\x53\xea\x50\x05\xd2\x68\xd9\x9c\x89\xa2\x59\x35\x45\x06\x25\xb9\xca\xb6\x30\x49\x59\x8d\xa9\x12\x61\xeb\xeb\x74\x01\x39\x4a\x61\x6e\x55\xd4\x04\x47\x9b\x52\xa4\xb2\xe1\xe9\x4c\x91\xcf\xce\xb9\x15\x15\x19\x97\x0b\x99\x5e\xb1\x86\x6d\xb1\x11\xd9\x76\xb9\x61\xb2\x39\xce\x64\xf3\x85\xa4\x5c\x9a\x6c\x66\x23\xb9\xce\x49\x5b\xab\xcc\x0c\x94\x01\x5b\xe3\x75\x91\x8d\x34\xa7\xf9\xf8\x14\x52\x82\xd4\xe9\x57\x7a\xf2\xa2\x3d\x34\xda\xc6\x24\x19\x11\xba\xcb\xfa\x76\x6e\xd3\x63\x30\xab\xc3\x5e\x4d\xec\xab\x13\x5e\x6d\x74\x07\xcc\x2e\xdf\x49\xad\x04\xb3\xb2\x2a\xa9\x7d\x54\x27\x5b\x1d\x56\x11\xa9\x32\x1c\xc9\x76\x72\x5e\xc8\x2e\xf2\x1d\xa7\xb0\xab\x36\xab\xed\xcd\xba\xa4\x4b\x79\xa5\xdc\x4b\xf7\xe9\xaa\xbc\xd8\x08\xa3\xa2\xa6\x17\x56\x83\x6e\x4d\x6a\x35\x5a\x4a\xb3\xd3\xea\x54\xe5\xd6\x6e\x51\xc6\x8d\x76\xdc\xcc\x93\x89\x5e\x6d\xb9\xa1\xcb\x69\x7e\x4b\xd6\x67\x69\x08\xed\xf6\x82\x2b\x55\x4b\x03\x49\x6d\x4b\xac\x58\xc2\xb6\x91\xe0\x33\x74\x95\xcd\x0f\xcc\x79\x32\xd9\xa6\xcb\x69\xd1\x1c\x19\x6b\x2e\xcf\x74\x8b\xd4\x50\x12\x2b\x0d\xb9\x50\x9a\x2f\xc8\x81\xb5\xd8\xf6\xb7\xf2\x9c\x2c\x27\x24\xb1\x9a\xc1\xe4\x90\xb6\xf8\x0e\x32\x0b\xf9\x49\x11\xcb\x1c\x4e\x5b\xa0\x5f\x50\x1d\xb1\xb3\xeb\x59\xfd\xf6\xb2\x33\xd0\xab\x91\x85\xb4\xc1\xd9\xc6\x78\xd3\x62\x68\x86\x14\xe9\x88\x58\x13\x12\x25\xab\x2c\xb1\x3c\xb4\x67\xbb\xcc\xb8\xd3\x5a\x51\x1b\x41\x4d\x26\x4b\xb5\xaa\x9e\x8e\x74\xec\xf5\xae\x16\x2f\xed\x12\x2b\x33\xc3\x67\x27\x55\x36\x0f\x50\x76\xcb\x47\x9a\xf9\x8c\xd3\x88\x64\x67\x06\xcf\xc6\x93\x16\xaf\x89\x64\x7a\x2d\x56\x85\x56\x67\x20\x64\x7b\xea\x32\x5e\x6c\xa0\x65\x76\xd6\x6a\xa3\x4d\x92\xc5\xf3\x66\x92\xd7\xb2\x05\x4d\x54\x27\x02\x9d\x25\x97\xb5\xd2\x48\xa1\xd6\xa3\xd1\x2c\x31\x5f\x28\x30\xd9\xd3\x8a\xe6\x92\x4e\xf4\x23\xed\x96\x6a\x4d\x23\x8d\x5d\x23\x2b\x0b\x0d\x5d\xb4\x44\x6d\x50\x48\x68\x9b\x01\x25\xe3\x64\x83\xa3\xd2\x11\x8e\x8e\xb0\x4b\x1a\x35\x0a\x91\xcd\x80\xe2\xd5\x88\xb4\x1a\x58\x4a\x45\x98\x22\xa6\x39\x21\xe3\xfd\x35\x35\x89\x54\x74\xb2\xc3\xf5\x58\x33\x0e\x58\xbd\x19\xd7\xd7\x40\x6a\xe7\xb9\xb4\x02\xd4\x29\x8d\x0a\xaa\x02\xd1\x58\xed\xa7\xca\xec\xa6\x3e\x4e\xb0\xfd\x89\xdd\xe8\x02\x39\x1b\x2f\x03\xc0\x77\x8a\xf5\x6d\x41\x6e\xf0\x12\x49\x0e\x2b\x64\xa9\xc3\xb6\x1d\x7b\xaa\xee\x6a\xc5\x64\x4f\x2d\x8e\x25\x6d\xb6\xec\x76\xc1\xb0\x62\x6e\xb8\x64\x49\x89\xcf\x57\x71\x20\x08\x6c\xc5\xa2\x93\x74\xa1\xc7\xcf\xbb\x59\x27\x25\x4c\x8b\x02\xbf\xdc\xf6\x46\xeb\xba\xa3\xb6\x29\x3e\x1e\xc9\x94\x3b\xf3\xfa\x60\x4c\xc7\x11\x1d\xd9\xac\x6a\xa0\x54\x63\xf8\x52\xbb\x8e\x56\x3d\x5b\xd3\xf2\x0b\x71\x54\xcf\xaf\xb2\x65\x34\x32\x56\x6c\xad\x5c\x61\xb9\xc1\x76\x51\x9d\x96\xa6\xfd\xfe\xa2\x31\xb6\x70\xbf\x9c\xb6\x0a\xb2\xb0\xed\x9a\xfc\x6a\xa6\x25\x97\x6c\x72\x11\xe7\xfa\xd9\x56\xab\x33\x2b\x67\xaa\x60\xe8\xec\x24\xba\x65\x28\xd9\xf5\x70\xa7\x5a\x6a\x62\x95\x9f\x65\x37\xe2\xd2\xd8\x0e\xa7\xfd\x5e\xa6\x35\xec\xa4\xba\x80\x6d\x27\xf5\x62\x5c\x2f\x17\x9d\x04\x5d\x25\x99\x76\xde\x9c\x17\x87\xb0\x30\xed\xc3\x0a\x72\x3a\x85\x78\x1b\xd9\x85\xfe\xba\x5d\x4f\xb6\x17\xd5\xd1\x7a\xb0\xae\x46\x1c\x6d\x38\x31\xaa\x3d\xb0\x9d\x0a\x5b\xa1\x36\xd8\x50\xf1\x7e\x3a\xdb\x10\x76\xa6\xc8\xac\xbb\x8b\xac\x51\xb6\x7a\x48\xaf\x96\x9c\x79\x4b\xb1\x8a\x10\xeb\xdb\xa5\xda\xad\xe5\x23\xc5\x61\x1a\x16\xd8\x71\xd5\xb6\x48\x90\x48\xd7\xe7\xdc\x68\x93\x68\x2a\x59\x2e\xb3\x2c\xc8\x6c\x22\x2d\x36\x75\xcb\x2a\x0e\x65\x76\x30\xa1\xe8\x11\xd5\x01\xb3\x0d\xe5\x2c\xd7\xad\x54\x31\x33\x2b\x88\x7a\x07\x8c\x76\xf4\xb6\x33\x9c\x82\x12\x6b\x2f\x9b\xbd\x75\x25\x5e\x98\x57\x6b\x4e\x6f\xb6\x34\x0b\xe9\xf1\x70\xc8\x18\xec\xb2\x49\x26\xe8\xae\xe5\x44\xf8\x91\xb5\x54\x80\x96\x5d\xf4\x32\xb8\x93\x15\x7a\xe5\xec\x6a\xa7\x8c\x95\x34\x3f\x17\x36\x8e\x9d\x14\x8c\xfe\x0e\x4f\xb7\x7a\xc5\x6c\xda\x49\x1b\x76\x97\x8d\x42\x61\x58\x89\x97\x53\xa9\x71\xb6\x37\x2c\xcb\x72\x56\x50\x33\xf1\x24\x2c\xe6\xc5\xe9\x84\x6a\x17\x0b\x83\x1d\xe2\x45\x93\x6e\x29\xc9\x69\xd5\x69\x56\xcb\x64\xa7\x2f\x52\xd6\x6e\x9a\x1e\x16\xb4\xce\x4e\x98\x80\xbc\x2c\xf0\x6a\xa2\x21\x66\x9c\xee\xd2\x68\x98\xf2\x86\x34\x44\xae\x8d\x8d\x16\x9e\xd6\x3a\x6a\x01\x1b\x9c\x9c\x19\xce\x4a\x5c\x3d\xdb\xd3\xa6\x43\x0c\x6b\x49\x1c\xd7\x0a\xbd\x62\xbb\x2f\x4b\x9d\xee\x30\x3b\x59\x97\xa7\xca\x42\x17\x00\x63\x8c\x45\xd0\xe9\x34\x51\x87\x8a\xf4\x05\x1a\x4f\xa1\x25\xd8\xb8\x97\x32\x52\xb0\x43\x09\x11\x66\x60\x4b\x91\x09\x59\x53\x16\x99\x6e\xbe\x95\x6e\x0a\x66\x39\x5d\xe0\xe3\xd5\x41\x63\xa4\xe3\x05\x9b\x30\x1b\x46\x81\x5d\x75\xaa\xd9\x5d\xbe\x50\xef\x25\xa9\x62\xb3\x98\xd9\x50\x9d\x24\x13\xa9\x54\x05\xbe\x6e\x4f\xed\x91\x90\x11\x18\x65\xe5\xac\xe6\xa3\xf2\x22\x19\x99\xa5\xd4\x5e\x6b\xb7\xa8\x92\x99\x59\x44\x24\xf9\xe6\x6c\xba\x65\xb7\x3d\xa8\xcb\x0b\x44\x6e\x33\x1c\x99\x95\x6b\xb2\x22\x95\x69\x64\x37\xba\x36\xca\x0f\x94\x9d\xdd\x29\x67\x37\xad\xc2\x74\x6e\xc1\x56\xb5\x50\xb7\xbb\xd4\x70\xc1\x2d\x67\x33\x4a\xdf\xcc\xed\xc2\xce\x61\x14\xc9\x52\x85\x59\x55\x99\xa3\x32\x9d\xcc\x16\x17\xe6\x06\x59\x59\x85\xae\x6d\xcd\x6a\x35\x33\x9a\x36\x53\x72\x57\x05\x13\x35\x39\x24\x57\x99\x84\x8c\x85\x54\x57\xb6\xd0\x2c\x93\xac\xc6\x8d\x41\x01\x91\xf3\x55\xb1\x5a\xc6\xbd\x44\xab\xa9\x6e\x97\x7d\xd1\x64\xa4\x34\x47\x93\x7d\x68\xd1\xd5\xdd\x96\xb3\xca\x95\xd2\x0e\xf7\x3a\xed\x44\x67\xd6\xeb\x8c\xf8\x44\x39\x5b\x23\xe9\x38\x68\x68\xbd\x88\x94\x42\x6b\x6d\x8e\x1b\x3d\x3b\x82\xb8\x75\x97\x9e\x19\x74\xaa\xc2\x97\xe5\x74\xa6\xd9\xab\x33\xc5\x42\x7e\x5a\x1d\x57\x36\x64\xc2\x70\x56\xf5\x46\x66\xdd\xa9\xee\x38\x39\x01\x99\x2a\x23\x8d\xfb\xa3\x86\xd6\x5b\x8f\x93\x1d\x31\x4f\xdb\xbc\x15\xe9\x95\x23\x4a\x9a\x03\x2d\xd6\xc9\xb3\x62\x72\x00\xf4\x89\x90\x2f\x0e\x5b\xbc\x50\x36\x13\x2d\x27\x8f\xd7\x23\x36\x69\x3a\x12\xcc\x47\x0a\x89\x02\xab\xaf\x53\x68\x52\x6e\x45\x76\xa4\x6e\xa6\xf2\x45\xa4\xe2\xe2\x4c\xd4\xb6\x0b\xb8\x5b\x2e\x5b\xe2\x4c\x1f\xd6\xf2\x0c\x1c\x74\x22\x8d\x2a\x25\xf6\xc8\x32\x9c\x96\x9d\xce\x20\x99\x28\x2f\x0a\xcb\x65\x05\x17\x18\x21\x3b\x61\xb6\x45\x33\xcf\xae\xc6\x63\x53\xd2\x22\x55\x8d\x12\x3b\x5b\x00\xb7\x93\x48\xd5\xa6\x84\x7c\x7f\x9e\x5f\x8a\x35\xd6\x1c\xc7\x87\x12\xdd\x77\xc3\x82\xfc\x70\x3c\xe9\x0e\x9a\xc9\xe2\xbc\x5e\x7f\xbf\x31\x4f\x02\x14\xfc\x1e\x2a\x58\x5b\xa2\x0d\x89\x3c\x51\xf4\xc2\x9a\xd0\x3e\x5c\xdb\xcf\xc0\x02\x0b\xa3\xe3\xfd\x1a\xc1\x3c\xe3\x79\x72\xe8\xe3\x34\x8c\x7a\x23\xfd\xc0\xf2\x33\xe6\xf4\xf7\x80\xf9\xa1\xcf\x61\x03\x10\xe2\x61\x6c\xb9\xb6\xa0\xb1\xf5\xe2\x29\xff\x6b\x94\x89\x31\x31\x3a\x66\x2a\xb2\xea\xed\xf7\x59\xde\xdf\xee\xb3\xce\xc8\xe4\x2c\x92\x4d\x25\x4b\xbb\x2e\x65\x8c\xd2\x80\x6d\x26\xe8\xc6\x10\xf7\xeb\xf9\xf5\x44\x1c\x4c\x76\x3a\xbb\x43\x49\x53\x9d\x35\xf5\xc4\x5c\x18\xd8\xb5\x48\x06\xb0\x78\x54\xa6\x7b\x72\x6a\x29\xef\xd0\x11\xf0\x5b\xfb\x7e\xde\x48\x9f\xfa\x8f\x7b\xbc\xf0\xda\xd2\x8c\x71\x0a\xb2\x78\x41\x01\x86\x1f\x20\x82\x25\xd8\x90\x8a\xcc\x9a\xa4\x8e\x74\x1d\x1a\xb1\xa5\x49\xd2\x31\x3a\x11\x4b\x93\x96\xca\xef\x13\x9f\x60\x72\xdc\x8d\xc3\x11\x55\xd4\x6b\x6b\x7e\xd8\xe8\xa7\xa4\x06\xde\x26\x9b\x13\x5d\xc2\x3d\x69\x37\x5d\x66\xa7\x5d\x9a\x53\x6a\xa3\x76\x15\x30\x8d\xd2\xc2\x31\xb4\xfe\x3a\x61\x56\x32\x29\xbe\x5e\xeb\x94\x76\xd4\x94\xfe\x93\x98\xfc\xc2\x9e\xb4\xe5\xf9\x96\xb4\x07\x1c\x36\x96\x43\x75\x22\x6e\x79\x4a\x67\xf4\x59\x81\x36\x06\x32\xbb\x18\xe7\xe7\xa8\x5e\xdf\xa6\xba\x46\x3f\x35\x31\x96\xf5\x32\xa8\x08\xa4\xd6\xa8\xee\xea\x9b\x4a\xc9\x14\x12\x1b\x6a\x53\x6f\x47\x0a\x54\x7a\x39\x68\xff\x79\xcd\x78\xb9\x27\xcd\xdb\x85\x64\x72\xc8\x80\xff\xa4\x63\xd9\x18\x7d\x94\x10\x7d\x82\xb5\x64\x69\xba\x33\xb2\xc3\x04\x10\xd7\x43\x66\xda\xb4\x7b\x86\x54\x69\x36\x80\xa8\xcf\xb7\xb5\x6e\xc1\x14\x18\xb2\xb4\xb1\x4a\xcd\xee\x60\xbb\x2e\xda\x71\x73\x0e\x8d\x2c\x47\x96\x37\xbc\xd4\xeb\xb6\x32\xc5\xaa\xf4\x1d\xac\xfd\x23\x1a\x25\x4a\xd0\x86\x0a\xd2\x55\xa8\x61\xc2\x86\x86\x29\x23\x8d\x40\x02\x31\xb1\x82\xf9\x1a\x09\x2a\xba\x60\x29\x04\xe7\x2f\x0e\x13\x0a\x12\x45\x59\xf3\xa6\x2c\x9e\x17\x8d\x6d\xc1\x7f\xc6\x63\xa9\x18\x4d\x05\x9b\xf4\x2c\xf8\x29\x8e\x4b\x51\x64\xad\xac\xb2\x63\x49\xc9\xc8\x40\x3a\x51\x6d\xd5\x60\x72\x54\xee\x1a\x23\xb9\xc6\xf4\xb1\x93\x2c\xcd\xe2\x0b\x27\x3b\x23\xc5\x34\xb7\x5e\x66\xe8\x69\xbc\xcd\x95\xdb\x9b\x64\xb1\xd9\x35\x77\x1b\x9e\xcd\x2c\xc5\x00\xf0\x43\x31\x10\xd1\xe8\x97\x5b\xf9\x1a\x2b\x4f\xb4\x6e\x06\x47\x40\x4b\xb1\xc6\x13\x4d\x4b\x0e\x7b\xbd\x2a\xd9\x61\xe1\xa2\x58\x4b\x8d\xa6\x75\x1b\xcc\xea\x2a\x29\x96\x58\x0b\x0f\x6c\x5c\x86\x65\x65\xb7\xd9\x4c\xc1\xa2\x13\xa9\x92\x8b\x7a\x99\xaf\x93\x42\x64\xfb\xe7\xb7\xee\xc0\x9b\x00\xfc\x73\x1b\x39\xea\xcf\x2a\xfe\x93\x89\x51\xb1\xd4\x41\x3e\x41\xea\xbd\x16\x1f\x0d\x0a\x65\xbb\x33\x1f\x08\x9a\xb3\xe4\x9d\x2d\x29\x8d\x27\x65\x79\xda\xef\x2a\x2c\xc5\xf7\x3a\x5b\x39\x52\xa4\xc8\xae\xb5\xe8\xce\x77\xad\x9e\x9d\xed\xa5\xdb\x71\xbc\x88\x2f\xd7\x4d\xd8\x9d\x45\x56\xfa\x90\xf9\x8b\x5b\xfc\x3e\x5f\x4f\x34\x3f\xec\x0c\xab\xf6\x3c\xcf\xa2\x31\x69\x0a\xdd\x04\x5f\xb5\xe9\x75\xa6\x98\xcc\xa8\x46\xa7\x61\x66\x19\xab\x80\xb6\x1a\x39\xe9\x27\x87\x99\x48\xb3\x40\xce\xd6\xaa\x8c\xb8\x72\x29\xbf\x12\x79\x50\xac\x76\xdb\xa3\xbf\xd2\x6e\x3d\xde\x4b\xfb\x80\x39\x04\x56\xcd\xca\x6c\x8a\xad\x25\xdb\x98\xa5\x9d\xea\xa2\x16\xaf\x33\x3b\xba\x3d\x5b\x67\x56\x1c\x35\x58\x0b\x6d\x6d\x5b\x29\xcc\x39\x5c\x28\xb4\x49\xba\x9a\x34\xb2\x0b\xbd\x55\x4d\x43\x13\xa6\x84\x11\x6f\x25\xbe\xc6\xdc\x29\x7b\x47\xfb\x6b\x37\x51\x0c\x55\x5d\x01\x18\x7e\x2e\x15\x15\x83\xcd\x41\xa3\x7d\xce\x61\x02\xf7\x78\x05\xc6\x5f\x35\x3d\x2c\x82\x44\x39\xc5\x32\x5d\xdd\x38\xec\x18\x35\x15\x99\x87\x21\x22\xe7\x82\x0d\xef\x53\x7f\x0d\x13\x11\x42\xe6\x83\x65\x27\x6f\xd9\xd4\x06\xca\x95\x15\xa3\x37\x74\x58\x49\xbb\xb2\x59\xe9\x74\x99\xe1\x4d\x91\x89\xdc\xc9\x62\x64\xf8\x87\x0b\x84\x76\x54\x40\xc6\x7b\xe8\xc5\xa5\xbb\x6a\x20\x4b\x7f\x25\xbc\x4d\x02\xdf\x08\x59\x23\xbc\x25\x88\xba\xe6\xa5\x9b\xa1\x00\x98\xc7\x40\x14\xa3\xf7\x90\x57\x30\x44\xe4\x02\x82\x7e\x23\xc2\x80\xc3\xb2\x0d\xc3\x39\x1f\x06\xf1\xfe\xfe\x4e\x50\xc4\xef\xae\xcc\x4f\xd6\x28\xde\x48\x74\xb2\x3e\x71\xbc\xc4\xf8\xc9\x95\x76\xba\x4c\x70\xab\x9c\xb7\xbc\xf3\x25\x36\x1e\xd3\x7b\xb6\x9c\xf2\xb9\x5d\x36\xc0\xe3\x26\xec\x21\x7b\x60\x5d\x0a\x58\x59\xe3\x73\x6e\x8a\x9f\x7f\x48\x5a\xc1\x60\xe5\x2d\x66\x59\x32\xef\x0a\xe3\x00\xef\x94\xbd\x60\x99\xe6\x24\x0d\x5c\x30\x7c\xbc\x6b\x31\x44\xe4\xfc\x55\x83\x2b\x2d\x7b\x65\x3d\xd3\x6b\xba\xf7\x90\x57\xf3\xde\x72\xf1\xed\x1d\x92\xc1\x9a\xa2\xbf\x45\x35\x58\xe6\xbc\x5c\x48\xbe\x00\x68\x1a\x51\xa4\x29\xdb\xd0\x47\xcf\x80\xb6\x8c\x2c\xf3\x4a\x95\xf3\xd5\xad\x3b\xac\x6b\x70\x83\xbf\x8f\x75\xaf\xe6\x97\x58\x3f\xec\x87\xfc\xa3\xac\x77\xe0\x06\x3f\x64\xfb\x7c\xa9\xf1\x4d\x32\x08\xf2\x10\xe2\xec\xf3\xbe\xc7\x84\xf5\x7c\x13\xc6\x9f\x9b\xaf\x33\xa5\xe2\x89\x43\xe7\xbc\x6e\xe0\xdc\x9c\x60\x67\x9d\xbf\x19\x08\x1b\x96\xc6\x79\x68\x72\xde\x91\x93\x7d\x5f\x37\x94\x63\x41\xff\xf8\x1b\xb1\x4f\xde\x6f\x03\x39\xe7\xf6\xd2\x8c\x5e\xd9\x57\xed\x6a\x15\xd2\x72\xae\x29\x87\xc8\x86\xc6\x7b\x68\x87\x90\x3a\x3c\x94\x3c\xc9\xb7\xf0\x7b\xc8\xd2\x6e\x17\x50\x91\x0d\xdf\x43\xde\x6e\xa6\x05\x42\xea\x54\xc6\x52\xd1\xdb\xbc\x72\x62\x72\x65\x55\x24\xec\xa8\x2c\x04\x8c\x49\xc0\x3c\x86\x96\xf3\x46\x45\x2f\xe7\x93\xde\x1e\xc0\x52\xe8\x44\x62\x2e\x90\x33\xa6\x42\x44\xce\x8b\x88\x0f\xe2\xf2\x29\xe3\x14\x99\x5b\xbd\x87\x90\x0e\xb5\xe1\xe9\x9e\x9c\xd0\xa1\x2b\x1c\xd1\x05\x15\x13\x7e\xd7\xfa\x1e\x74\x7f\x96\xcd\x42\xbe\x9d\xcf\xe7\x4b\x3a\x55\xa3\x75\x6f\x7d\x8f\x2e\xb4\x27\xe5\x99\x9c\x88\x8c\x13\xbd\x71\x95\xb1\xd8\x6d\x67\xd5\xe8\xb5\x77\xb8\x28\xeb\x4d\x9e\x81\x4c\xb2\x33\x9e\x4c\xe4\x85\xba\x66\x32\xb3\xe6\xda\xad\x53\x9c\x15\xea\xd3\x99\x0b\x27\x5d\xce\xe7\xf3\xdd\x4d\xbe\x3a\x69\x3a\x09\x36\x9f\xcf\x57\x58\x4a\x29\xf7\x27\x83\x84\xd6\x65\xe6\xa3\x89\xc0\x0e\xa4\x61\x2d\xc3\x95\x6d\xa7\x50\x1f\x95\x8a\x4e\x05\xf0\x75\x8b\x9b\x4a\xb2\xa2\x35\x90\xba\x4d\x63\x6d\x3d\x5a\x24\xd6\xf3\x4a\xcb\x29\x0b\x65\x9d\xed\x77\xba\xc5\x1e\x33\xb3\xed\x5d\x59\xdc\x39\xd3\x4a\x41\x2b\x26\x53\x1a\xce\x24\xcd\x21\xa3\xef\x4c\x53\x58\x4e\xfb\xc9\x9d\x58\xce\xff\xb1\xa7\x94\xb0\x19\x85\x4b\xa9\x56\x7a\xd5\x10\xa6\xe9\x8c\xd0\x4b\x91\xf1\x11\x9f\x22\x69\x5b\x98\xc9\x49\x43\x1d\xf7\x3a\x49\x32\x93\xc4\xd3\x8e\xcd\x4e\x34\x2b\xd9\x07\x82\x55\x35\x98\x8d\xbc\xeb\x67\x79\xca\xaa\x4a\x34\x4c\xf4\xe6\xd9\xac\xbd\x96\xab\x4a\x72\x25\xb0\x99\x36\x5c\xb1\xa0\xbb\x2e\x6a\xe3\x38\x5f\x92\xd0\x5a\x5e\x65\x46\xdd\x6c\x7d\x46\x0b\x2b\x3c\x9a\x44\xec\x5d\x24\x52\x6c\x59\x33\x9c\x4d\xf0\x5a\x4f\xe5\x5b\x54\x2a\x35\x5e\x02\x56\x9b\x32\x8d\x59\xc3\x60\xdb\x4c\x45\xe9\x52\x23\x30\xd3\x0d\x81\x5d\x1a\x33\x4c\xce\x97\x0a\x33\x4a\xa4\xe2\x9b\xb8\x30\x55\xb1\xd0\x06\xdd\x85\xc2\xd0\x6a\x86\xa2\x85\x41\xdc\x8c\x67\x16\x73\xbc\x8a\x18\x6b\x61\x95\xaa\x32\xeb\xdd\xb2\x40\x69\x63\x46\x12\x13\xbd\x71\x22\x31\x11\xb4\xc9\x2c\xb1\x98\x9a\x8b\xf5\xa6\x41\x91\x11\xbe\xdc\x6d\x25\x7b\xc9\x6c\x29\x6b\xdb\x29\x47\xd0\xd6\xa0\x40\x39\xc9\xd9\x6a\xd9\x1b\x0a\x6b\x32\x1d\x97\xac\xb8\x39\x35\x6a\xcc\x26\xdd\x2b\xc2\x9d\x61\xb4\xdb\x02\xad\xf7\xf2\x3c\x37\x29\x65\xcb\x64\x51\xea\xd0\xed\xde\xae\x0f\x23\x3c\x23\xed\x66\x14\xea\x27\xd5\x88\x5d\x5a\xa7\xaa\x69\x69\x6d\xa7\x87\xb3\x1a\x2e\xe5\xc1\x9c\xd7\x13\x9d\x89\x06\xc8\x71\x5f\xa4\x1a\x42\x2f\x92\x9e\x0f\xa4\x44\x82\xae\xa8\x35\x9c\x30\x5b\x64\xd5\xe8\x8d\xd2\x4b\x9d\x8c\x34\xb3\xd4\x1a\x24\x6b\x4b\x43\x90\xab\xd3\x38\x1e\xcd\x35\xae\xba\x25\xc7\xa9\x7e\x6d\x20\xa7\xed\x76\x9e\xca\x34\xbb\x4c\x51\xe5\x47\x8a\x31\xa7\x26\x16\x33\xda\x39\xcd\x5a\xb7\xa9\xb1\x4d\xa9\x3f\x8d\xeb\xc3\xf1\xa8\xa4\xf4\xb6\x6c\x8a\xea\x4f\xdb\xd9\x4c\x0f\x90\x71\xbb\x5d\xdc\x90\xa0\x50\x2f\x25\x36\x1c\xa3\x96\x41\xa4\x5d\xd0\x94\xfe\x46\x06\x92\x6a\x29\x6b\x92\xea\xf5\x33\x5c\x6a\xbd\x29\xa5\x66\xf4\x40\xe4\xe3\x9d\x61\x26\xdb\x4f\x15\x13\x66\x8a\x2d\xed\x6c\xb3\xb8\x21\x17\x94\xa2\xcd\xa6\xf3\x82\x91\x76\xa6\xd3\xf8\x6c\x46\x21\xc3\x49\xcc\xb1\xb4\xdb\x38\xeb\x5e\x47\x83\xb5\x4a\x2b\x2e\xcf\xd5\x72\x24\x9d\x4c\x8f\x41\xaa\xdc\xed\x75\xdb\x8d\x35\x27\x2d\xd5\x42\x9f\xb4\x12\x91\xb5\x9d\x9f\xce\xf9\xc6\xbc\xa3\x48\xd3\x8c\xa5\xd1\xd0\x51\xd4\x06\xa3\xb7\x6a\x45\xd3\x74\x92\x76\x45\x92\xe6\x85\xe4\xbc\x11\xa1\xcc\x75\xcb\x5a\x4c\x48\x92\xa2\xd6\x9c\xc5\x69\x6c\x3b\x29\x8e\x3b\x69\x7e\x67\xb7\xf3\x71\x8e\x6f\xa0\xda\x52\xcb\xd0\x5d\x03\x67\xc8\x22\x17\xdf\x3a\xad\x5a\x37\x8d\x1b\xb5\xa2\xb3\xe3\x54\xbc\x2e\xb3\x99\x66\xd7\xd0\x48\x63\x34\x36\x67\xac\xd1\xdf\x6c\xd6\x55\x33\x13\x61\x55\x73\x51\x40\xbd\x19\x43\x36\xe3\x9a\xad\x2a\x76\xbc\x54\x2d\xd7\x96\xeb\x2c\xcf\xa8\xe5\xe1\xb4\x9b\xec\x91\xeb\x9d\x31\x14\xc6\xb3\xcc\x6a\x96\x58\xe5\xa7\x5d\x9e\x65\x96\x5b\x61\x2c\xb4\xc4\x15\xa7\x93\xa5\xbe\x53\x4d\x8e\x77\xa2\xc6\xa5\x2c\x6b\x26\xf0\x5b\xbd\x3d\x4d\x31\xc5\x8d\x82\xd7\x28\x93\xcc\xac\xab\x76\x3a\x13\x19\x66\xed\x7a\xad\x2b\xd8\x23\xa9\xdf\x4b\x67\x9d\xd1\x14\x74\xda\x0e\xae\x64\xaa\xaa\x69\x36\x4d\xb3\xb8\x19\x2d\xd7\x5c\xaa\xd4\xe9\x55\x46\x52\x37\xc1\x55\x0b\x49\xd6\x26\x59\xb5\xb0\x18\xa0\x4c\xa4\x48\x6e\x7b\x2a\xd9\x13\xc7\xec\x6c\x26\x4f\x48\xbb\x31\xb6\x53\xc3\x44\x59\x33\x85\xa9\x68\xd6\x3a\x86\x9c\xe5\x19\xcd\xa5\x4b\x58\xdb\x1c\xab\x26\x8c\xed\x34\xbd\x55\x47\x45\x4e\x98\x4c\xc5\x09\x6d\xab\x45\x52\x57\x17\xa6\x10\x6f\x41\xc6\x9a\x0d\x47\x4e\x45\xad\x0d\xa7\x25\xbe\x26\x8d\xba\xa4\x92\xef\xc0\xf4\x60\x5e\x45\x8b\x56\xaf\x6f\x72\xa9\xd4\xa6\x54\x9d\x16\x36\x22\x1f\x6f\x64\x35\x41\xc6\x91\x36\x63\xb6\x7a\x6c\xaa\xac\x80\x8e\xb4\xec\x96\x22\x3b\x56\x4d\xb6\x57\x5c\x67\x21\xd5\x58\x19\x2b\x91\xc2\x3c\x95\xb5\x34\x16\x6b\x60\x29\x0c\x65\xa5\x2d\x38\xad\x5a\x61\x92\x4c\x67\x06\x9d\xcd\x7c\x01\xab\x93\x5e\x63\xe9\x34\x13\xa9\xcd\x44\x8a\x0f\xd7\x9c\xa6\x4d\x17\xfc\xac\x29\xef\xac\x6d\x56\x5d\xf4\xe9\x7a\x75\x57\xb2\xec\xfc\x7a\x43\x2a\xc5\xe5\x66\x9e\x21\x29\xbb\xc2\xea\x46\x65\x9d\x4e\xb9\x70\x68\x27\xbb\x9b\x4e\x4b\x62\x16\xcd\x23\x4d\x41\x4b\xcf\x6c\x71\x30\x4f\xeb\x1b\x7d\x4b\x8e\xb8\xdd\x98\x31\x5b\x63\xc6\x5c\xca\x86\xcb\x13\x0f\x8b\x85\x85\xba\x5b\x74\x8d\xec\x86\xa5\xda\xf3\x64\xc6\x1e\x39\x95\x19\xdf\x71\x96\xe6\x62\xd9\x92\x56\xad\x61\x33\x55\x1a\x39\x40\x5f\xd8\x59\x34\xcb\xd3\x38\xb5\x12\xd9\x76\x37\x95\x29\x45\x22\x6d\x67\xc6\xf0\xfd\x06\xae\x6d\x32\x8b\x44\x69\xd1\xa1\xb5\x21\x6b\x17\xb3\x4c\x89\xcc\x30\x70\x1d\xef\xc9\x83\x5e\x61\x4d\xd7\xc0\x62\x65\x66\x7a\x6a\x01\xb3\xcc\x62\xb8\x58\x50\xb4\x5a\xe6\x23\x2d\xaa\x35\xe3\x54\x21\xc9\xcc\xe8\x78\x76\x44\xce\xca\x4e\x69\xc2\xcc\xa6\x48\x70\x92\x15\x49\x4d\x44\x60\xad\xce\x9a\x46\x97\x4c\xa1\x89\xd4\x4f\x6e\xab\x1a\x5b\x6d\xeb\x1a\x4d\xb6\x4b\xc0\x96\x6a\x43\x7a\x94\xe9\x51\x4e\xca\x70\xba\x55\xd5\xaa\x8e\x6a\x3d\x45\xb1\xc5\x4c\x23\xce\xb3\xbd\x3c\xbf\xa0\xf9\x11\x6c\x57\x48\x4d\xea\x47\xf4\x0c\xbb\xe3\x98\x22\x29\xec\x0a\xa5\x48\x2a\x3e\xcb\x58\x0c\x58\xd7\x48\x7b\x52\x4c\x28\xa4\xdd\xd8\x65\x7a\xbb\xd9\xb0\x5c\x8b\xd8\xeb\x88\x9a\x1e\x08\x11\xa5\xaf\xda\xd9\x36\xcd\x75\x74\xa9\x32\x92\xda\x34\x93\xe0\x3b\x2c\x1b\x4f\xc9\x1a\xca\xa6\x12\x55\x2c\x56\x23\xc3\x88\xbe\xd2\x8b\xc2\x32\xb3\x93\xe4\xe9\x98\x94\x80\xd3\xec\x35\x5a\x85\x74\xdc\xd2\x12\x3a\xd5\xd5\x46\x54\x9c\x5f\x2e\x93\xc8\xaa\x64\x52\x1a\x97\x16\x32\x5c\x7a\xc0\x73\xf1\xee\x4a\xc3\xda\x6e\x97\x58\xa5\x27\x76\x76\xa4\xc2\xf4\x28\xdf\xd5\x6a\x13\x50\x70\x1c\x81\x24\x37\xb4\xa6\xb3\xc9\x2e\x39\xa8\x2c\xec\x81\x31\x8f\x58\x94\xca\x8f\x5a\x43\x7d\xb4\x2b\x49\x52\xb5\x96\x1d\x0c\x23\x33\xd5\x62\x46\xa5\xc4\x8c\x67\x04\x98\x8e\xcc\x2c\x61\x40\x15\xff\xe0\x98\x94\xe9\x90\x89\x0a\xc3\x64\xe4\x1d\x5f\xdd\x4c\xa7\x99\xcb\x79\xf6\x47\x2e\x86\xff\x5b\x43\x27\x5e\xc7\xa7\x13\x71\xdb\xff\xf2\x00\xb2\x88\xdf\x9e\x78\x42\x52\xf2\x24\xdf\x73\xf7\x42\xc7\xbe\x91\xfb\x67\xe4\xa5\x7e\xec\x1d\xbe\x43\x12\xf1\xfb\x1b\x29\x25\x9f\x01\xe7\xba\x34\x1f\x6f\x50\xfd\xe8\x20\xc2\x4b\x7c\x23\xa1\xfa\x71\x5e\x5b\x3f\xad\x7c\xe1\xd8\xfb\x5e\xf8\x3e\xd8\x0b\xfb\xe7\x6f\xbc\xbf\x51\x5d\x56\x14\xdf\x79\xf5\x4e\x7a\xf8\x5f\x1d\x03\xe8\x84\x1b\x41\x78\x65\x8a\x6e\xb5\x0a\x32\x86\x18\x60\xcb\x7c\xf9\xf6\xc9\x90\xe9\xa5\xb8\xdc\x78\xbe\xfc\x1b\xd8\x07\x85\x18\x88\xfb\x98\x30\x86\x81\x68\x1e\x22\x14\x0c\xc4\x98\xbf\xb7\xef\x6c\xc7\xd6\x9e\x83\x3b\xb4\x85\xce\x38\x88\xba\x14\xba\x00\x5d\x57\xdf\x23\xca\xfb\x01\x37\xd8\xa5\xe8\x34\x94\xd0\x9f\x6d\xe8\x2b\x7b\xf2\xc0\xe7\x5e\xd9\x3d\x91\x58\x23\x58\xac\x45\x91\x85\xbd\x63\x9b\xc1\x1e\x74\x2f\xcd\x54\x09\x0f\x90\xcf\xe5\xb9\x1b\x5b\x82\x18\xc8\x8a\xe9\xfb\xb0\x1f\x13\x19\x3a\x44\x90\xe4\x52\x7c\x14\xe8\x9d\xa3\x30\x21\x87\x34\xfe\x1a\x12\x42\x50\x10\xc0\xfe\x4e\xff\x83\x9c\x3f\x1d\x69\xef\x1c\xb8\x86\x0c\x28\x40\xc3\xf0\x36\x6e\x9f\xef\xd0\x93\x4d\x19\x7b\xfb\x3e\x8f\x85\x76\xba\x51\xf2\x8f\x44\x5b\x2e\x29\x35\xff\xf8\xd1\x08\xb0\x0a\xbc\x88\xba\xfc\x33\x49\xfb\x7d\x8f\xfe\x01\x25\xf7\x6f\xd4\xc4\x86\xac\x43\x3e\xf8\x25\xb9\x31\xce\x3e\x47\x25\x2e\x8f\x35\x1d\x05\x69\xd8\xcd\x38\x80\x74\x7f\x44\x15\x4f\x3e\xc7\xed\x8a\x8d\x53\x25\xc1\x12\x61\x72\x48\xf7\xf7\x4b\x86\x3e\x7c\x9a\xdf\x48\x2c\xdd\x2d\x36\x01\x8a\x05\xcf\x4a\xbd\x91\x47\xb0\xdd\xbc\xcf\x4b\x21\x5c\x00\x47\xdb\xfb\x03\x42\xf6\x7a\x13\x04\x93\xb2\x46\x04\x9c\x7d\xf6\x79\x2e\xd0\x42\x9f\xac\x17\x3f\xff\xdb\x99\x9e\xe3\x03\xd3\xc1\xf9\x2e\x0d\xa8\xbe\x6a\xf8\xbf\x63\xee\x6f\x57\x3b\x30\xff\xa0\xa2\x77\x30\xec\xb8\xa6\x7f\x52\xec\xbc\xea\x39\xa7\xc7\x27\x17\x48\xaf\x55\xbe\xbb\xdf\xf8\x1b\x93\xdd\x7e\x79\x2f\x4e\x37\x90\x43\x5c\x3d\x7d\x16\xba\x35\xab\x86\x94\x68\xe2\x4c\x6c\xc7\x93\x5a\xe7\x53\x57\x37\xe6\xa8\x2e\x66\x27\xce\x50\x64\xae\xa1\x38\x3d\x85\x17\xe0\x0a\x12\xf7\x21\x75\xd0\xee\x7b\xb4\x27\x55\x6e\x6e\x64\xfe\xa3\xfa\x69\x16\xb6\x9f\xbb\xd5\x6f\x89\xfc\xb3\x9d\xa5\xf8\x61\x6b\xb9\x7f\x80\x3d\x9a\xf0\xed\xb4\x7f\x0c\xea\xf4\xa4\x1f\xa1\xb3\x51\x26\xf4\xe1\x6d\x2c\x27\xd8\xd3\x6d\xf1\x52\xfc\xd4\x12\xfb\xe3\x67\x30\x51\x5c\xf7\x26\x22\xa3\x04\x4d\xbc\x79\xda\xfe\x59\xb1\xe8\x17\x30\x63\x0a\xd4\x44\x2c\x1d\xe6\x3c\x4f\x2a\xca\x1a\x11\xfc\x36\x47\x68\x28\x7d\xde\x12\x72\xd2\xe4\xfe\x4c\x74\xd0\x14\x7b\x71\x5c\x62\xfa\xe5\x9c\xa6\x7f\xf9\x33\x98\xc7\x1d\xc6\xfc\x42\x65\xaf\xfc\xc9\xde\x80\xf3\x19\xd2\xe7\x69\x38\x1d\xe8\x8e\xf9\xba\x35\xe8\x05\x27\x7b\xfe\x19\x0c\x4c\xa7\x62\x22\x22\xef\x04\x9d\x0c\x11\x87\x13\x55\x17\x05\x3e\xde\x1f\xb5\xc7\xd9\x20\x76\x3c\x3e\x2a\xa2\xf7\xe1\xdd\x74\x40\x9c\x1f\x21\x0b\x7d\x78\x08\xda\xc8\x80\x47\x27\x70\xfe\xb4\x3e\xee\x9d\x9a\xf8\x8b\xbb\x77\x70\x32\xe3\x4b\x3d\x7b\x4f\xda\x5f\xd5\x9f\xf7\xf0\xaf\xf4\xa0\xeb\x7d\xf8\x4e\x85\xc7\x3d\xf7\x3e\xb6\xff\xad\xde\x7a\x21\xe3\xff\xb4\x3e\xfa\x39\xde\xfd\x95\x5d\xf4\x56\xcf\x74\x45\x74\xd1\x2d\xcf\xfb\xe3\x67\xa1\xfd\x52\xd2\x95\x9e\x78\x34\x1a\x5f\xf4\xc3\x5f\x4e\xd0\x5c\xb1\xa1\xd7\xcb\x5d\xae\x1f\x5d\x87\xf4\xf1\x46\x1e\x61\x7f\xb2\x3f\x1d\xf1\x71\xa5\x33\x1d\xe7\xee\x7b\xd2\x7f\x6c\x17\xf2\x4e\x48\x3d\xf2\x98\xce\xce\xc6\x5f\x5f\xda\xf0\xcf\x5a\x7d\x02\x75\x45\x7b\x2b\x6e\xba\x7a\x72\xf9\xa8\x6e\xcb\xcf\xe9\x06\x19\x27\x61\x2f\xf3\x11\xe4\x12\x5e\xd1\x58\x2c\xf6\x46\x4a\xcc\x0d\xbf\x6a\x7f\x16\xfa\xf6\x52\xe8\xbe\x44\x94\x05\x06\xc1\x8a\x51\x59\x13\xd0\xb1\x68\xf6\x00\x82\x65\xb1\x7d\x71\x16\x18\xc1\x92\x96\xe7\xe5\x6a\xc8\x79\x0f\x51\xc7\x29\xaa\xac\x9d\xa7\x80\xcd\x7b\x28\x9e\xa4\xa8\x73\xc9\x5c\x74\xb7\xef\x76\xd4\x96\xc0\x06\x7e\xea\xc9\x05\x57\x96\xc6\x79\x77\x48\xe8\xc0\x30\xe1\x10\x9a\xa6\x8c\xb4\x17\xd3\xff\xfc\x76\x7e\x34\x59\x81\xd8\x5b\xf4\x23\xde\xcf\x73\x3c\xcb\xed\xef\x5d\xc9\x11\x41\xf5\x58\x90\xf0\x7a\x59\xd4\x0d\xf5\xcd\xcf\x82\xde\xcf\x2b\xc5\x3c\xfd\xc8\x11\xbf\xfc\xeb\x46\xde\xa5\xcf\xe0\x16\x3e\x2d\xfb\xfb\xe9\x4f\x01\x19\xc4\x8b\xcb\x87\x5b\x7f\x6c\x28\xae\xed\xd9\x93\xe1\xa1\xbb\x60\xda\x7d\x5c\xa6\xfd\xec\x98\x6e\x99\xd2\xcb\x49\x8d\x5f\x02\x50\xff\x3a\xbf\x67\xe2\x16\x6a\xd7\xba\x9c\xe3\xbd\x64\xe5\x2a\x21\x6e\xf5\xfd\xce\x87\xab\x8d\xe0\x3e\x2e\xfc\x9c\xf7\xf7\x8a\xdc\x4e\xe4\x7a\x99\xfd\xfb\x65\xd2\x85\xc8\x90\xf0\x80\xf4\x5f\x5c\xdc\xff\xba\xca\x80\xfb\x04\x0c\x3c\x21\xd0\xa7\xe8\x3b\x34\xce\x15\x17\xd2\x03\x1e\xe0\x7b\xd0\x3c\xf7\xc0\x98\xc8\xc0\x2f\x2f\xe0\x95\x60\xbf\x11\xef\x1f\xd7\xf8\x32\x20\xb6\x0c\x8d\xd8\xf7\x12\xdf\xa6\x13\x51\x82\x3d\x49\x38\xa7\xe0\x9c\xa4\x00\x8a\x4b\xca\xad\xdb\x0b\x26\x96\xb7\x21\x55\x47\x1a\xd4\xf0\x4b\xb8\x77\x2d\xde\x0a\xbf\x5e\xdc\x3e\x15\x98\xf0\x1c\x11\xfe\xe1\x6e\x88\x16\x3e\xeb\x32\x3c\x54\x64\x55\x0e\x54\x2b\xfc\xe3\x6f\xe1\x57\x22\xfc\x7b\xf8\x5c\x21\x5d\x82\x5f\xae\xb6\x77\xc0\xd1\xfd\x9e\x10\x0c\x89\x39\x82\x4e\x3e\x6c\xf1\xdf\xcf\x50\xeb\x06\xd2\xcd\xdc\x35\x04\xb7\x2c\x44\xde\x30\xc0\xf6\x66\x57\xf0\xda\xe4\x91\xb8\x0f\xae\xff\x53\x92\xbe\x08\x14\xfe\x2f\x13\xf2\x0d\x99\x9e\xc1\x70\xa5\x68\x61\xc8\xdf\x06\x13\x48\xea\x3a\x8f\x3e\x9f\xa6\xa5\x60\xd7\xf0\x5d\x31\x03\xc4\xb9\xa9\x72\xed\x14\x96\x64\xf3\x8e\x5d\xdf\x3f\xb2\x40\xbc\xf8\x13\x26\xc8\xc4\xde\xac\x96\xac\x05\xd8\xee\xd6\xfb\x24\xea\x97\x93\xea\xff\x3a\xb6\x6d\xee\xd7\x73\x45\x3f\x11\x14\xe1\x6d\x35\xf8\x1e\x34\xb7\xc7\x80\x13\xee\xf8\x1c\xf1\x6b\xcc\xd2\xe4\xb5\x05\xeb\xfc\x4b\xd8\xad\xbe\xdf\x3f\xf7\x6b\xf8\xdb\x8d\x41\xe2\xf8\xd9\x0f\x18\xee\xe7\x95\x41\xe3\x84\x9b\x3b\x8c\x5e\xcf\xba\x91\x1c\x74\xea\x5f\xfd\xb9\x42\xf3\x25\x68\x8e\x2b\x82\x3c\xef\xbc\xcf\x2a\xf3\xf0\x34\x46\xba\xaf\xcb\x37\x02\xaa\xff\x59\x55\x3e\x8a\x1f\xfe\xe7\xf4\xf8\x59\x71\x56\xf7\xf1\xc2\x7d\x41\x5e\x84\x15\xdf\x27\xc2\xe7\x58\xf9\x82\xe3\xf8\x47\x4d\x98\x0a\x56\xb0\x04\x30\x30\xe1\x3d\x13\xe6\x1a\x27\x0d\xf1\xd0\xf4\xac\xd8\x0d\xab\xe0\x16\x82\xbc\xe8\x15\xfa\xe5\x5f\x3f\x9f\xee\x5c\xfc\x7c\xbe\xd7\xda\x79\xc1\x2e\x4f\xbc\x13\xff\x76\xbf\xfd\xfa\xe3\x6f\x87\x3d\x94\xbf\xff\xfb\x8e\x9d\xf2\xc8\xf6\x23\x65\xfe\x09\xcb\xe3\x5a\x1d\xbf\xf0\x03\x03\xe3\xdd\xa3\x92\x3b\xec\x62\x7b\x50\x5a\x34\x90\xa5\xe7\x88\xb0\xee\x75\xb4\xfb\x65\x3d\xcb\x91\x23\xe8\x3b\x26\xe9\xa6\x70\x89\xe3\x61\x01\x03\xf1\xbe\x48\x89\xe3\xe6\xc0\x40\x74\x5b\xe3\xf9\x9a\x44\xd0\xe6\x18\x88\x7e\xbb\x60\x20\xfe\xfa\xe3\x6f\x18\x88\x31\x09\x98\xd2\xdd\x56\x39\xa6\xf5\x1f\x2f\x3e\x04\x59\xf3\x1b\xeb\xdb\x53\x98\x89\x43\xdb\x7a\xb5\x9f\x1c\x54\x0e\x78\xf9\x9c\x4f\xf7\x13\xc3\xc8\xfe\x09\x5a\x7c\xbf\xc0\xfa\x85\x9a\xfb\xd6\xc7\x40\x7c\xd4\xf8\xc7\xcf\xbe\x23\x3c\x59\xe5\x96\x5e\x9e\x94\x79\x6a\xd4\xde\x3f\xc7\xf2\x0d\x16\xbf\x22\xef\x04\xf3\x0c\x9e\xc7\x45\x3c\x53\xe1\xfb\x1a\x4f\x92\x23\x18\x48\x3d\x68\x27\x81\x51\xd0\x84\x4f\x50\x73\xcf\x91\x79\x40\xee\xef\x4f\xa9\x1a\xe0\xf9\x1b\xb1\xef\x09\x03\x7b\x5d\x73\x8b\x1f\x94\xed\xb9\xba\x44\xa0\x6d\x6e\x69\x5f\xdd\xdc\x6f\xbf\xfe\xf8\x9b\xfb\xf1\x05\x5d\x0b\xea\x7f\xaf\xb2\xf9\xd5\xbf\x43\xdb\xfc\x8a\x5f\x57\x37\xb7\xde\x77\xa8\x9a\x5b\xed\x7b\x74\xed\x7f\x57\xd5\x02\xe9\x1e\xe9\xda\x7f\x8c\xaa\xf9\xa4\xfd\xe5\xba\x76\x23\xf9\x81\x2b\xb1\x9f\x5c\x3a\xf6\x26\x9e\x9c\x9b\xda\x3f\xae\x76\x9c\x4e\xf1\x04\xb3\x21\x6f\xef\x04\xfd\x50\x49\x38\xa4\x61\x59\xb3\xce\xaf\xf0\x7c\x8a\x0b\xe2\x33\xda\xf5\x15\x7b\x1f\xe4\xfc\xf8\xdb\x9e\xa0\x27\xbd\x9c\x03\x94\x27\x1d\x9d\x43\xf9\xe7\x7c\x9d\x70\x20\xd2\x47\x7a\xb5\xd7\xc1\xcf\x73\x4f\xcf\xba\x3c\x44\xe4\x46\x23\xfc\x37\xc1\x5c\x99\x56\xdb\x3f\xf7\xfd\x21\xaf\x97\xec\x9d\xcd\x13\xe8\x0f\x9b\xf5\x6b\x8a\xe3\x2b\xcd\x15\xf7\xd4\xd7\xa0\x83\xb4\x1f\xe8\xc7\xdd\xa8\xfb\x8e\x86\xdc\x0d\x48\x7f\xd1\xa0\x43\xd8\xb2\x19\x73\x9d\xfd\x21\xc4\x2f\x87\x08\x35\x18\x06\x5e\x89\xf3\x12\x1e\xf3\xdf\x1e\xcf\xb6\x9e\xc7\x1b\x2a\xb2\x34\x2f\xdc\x38\x4c\xd3\x5f\x0f\x2c\x3c\x4d\xfd\x51\x83\x1b\x3c\x92\xb9\xd5\xcb\xcb\xad\x49\x4a\xf7\xf9\xf1\x25\xfc\x83\xbf\x2d\x2d\xfc\x2d\x26\xc9\x3c\x7c\xb9\x25\x25\xb7\xe4\x95\x25\x97\xf0\x37\xef\xae\xd6\x9b\xd5\xf6\x8b\x04\x6e\x1c\x44\xbc\xfb\xb4\x1d\xc7\x46\x0f\xaa\xdd\xd7\x37\x4f\xc4\xb9\x03\xfc\x5f\xa8\x6b\x4b\x03\xfb\xc7\x13\xfb\x51\x61\xfa\xc6\xd4\xc5\x8d\xae\xe0\x45\x56\xfb\x0b\x73\xdf\x3f\xc5\xb1\x5f\xf7\x09\x7f\xbb\xa9\x2c\x5e\x94\xe7\x1f\xd6\x24\xde\x0f\xdd\xa1\xe3\xa7\xbc\x1c\x20\x85\xbf\xb9\x0c\x78\x04\x5e\x84\xcc\x27\xe0\xc0\x16\x59\xf8\x6a\xd4\x79\xfc\xc8\xaa\x6e\x20\x1b\xf2\xad\xa0\xb8\x77\xc8\xf1\x8e\x7c\xce\xbb\xdb\xf1\x13\x08\xfa\x01\x46\x53\x02\xba\x1b\xd4\xf3\x08\xdf\x33\x4c\xf7\x10\x05\x8d\xf4\x70\x54\xf0\x2e\xdb\xfd\x6d\xff\xe2\x11\x37\x04\x40\xe1\xbb\x80\x3d\xfa\x54\x84\xb0\xf4\xc7\x24\xa1\x4b\x5b\x53\xe6\x1e\x93\x08\x35\x6f\x91\xf7\x31\x32\xc2\xb7\xa4\x1c\xcc\x63\x05\x98\xf1\x02\x30\x6f\xcc\x28\x5c\x70\xa3\x1b\xb2\x26\xb6\x3c\x3b\x9e\x23\xe2\x0c\xf5\x84\x57\xe6\xd7\x29\x22\xcd\xc4\x40\xc3\x39\x82\x8a\xd1\x99\x07\x56\xf3\x01\x54\x15\x6c\x26\x50\x41\x9c\x8c\xb7\x39\x82\x4e\xa4\x1e\x35\x01\x52\x6c\x68\xe4\x88\xf0\x39\xcb\x8f\x46\x32\x2c\xab\xd0\xc4\x50\x77\x69\x66\x92\x8f\xb0\x60\xc0\xca\x8a\xbc\x0b\x5e\x36\xf3\x84\x30\x0f\xcd\x85\x0d\xeb\x51\x6b\xb9\x8f\x8c\x83\xeb\xf6\xcd\x1c\x11\x4f\x52\xcf\xc8\xde\xd2\x79\x80\x61\x3d\x38\x74\xec\x56\xfb\x7e\xc9\xdf\xcb\xf3\xbc\x84\xc7\x1d\xd4\x9f\x32\x79\x4a\x38\x81\xba\x85\x7f\x88\x67\x40\x3a\x91\x0c\xff\xb1\x1e\xe3\x45\xeb\x5f\xc3\x4b\x51\x69\x56\x10\xfe\x28\x5e\x2f\x74\xf9\x1a\x62\x3a\x0d\xe2\x6c\xe6\x8f\x22\x3e\xf2\xd7\xbe\x86\x5e\x10\x38\x9a\x4a\x3f\x42\xff\x75\xaf\xe6\xf6\x50\x15\x0c\x53\x31\xa4\xbd\x84\x4f\xb4\xe8\x30\xcc\xbd\xba\x3e\x9f\x01\x54\xf3\x9e\x5b\x41\xec\x07\x4c\x68\x70\x50\xc3\xae\x8f\xf8\xbe\xaf\x17\xfb\xd4\x1d\x82\x24\x82\x34\x8c\x30\x50\xbe\x11\xff\x4d\xd0\xd4\xf9\x1d\xfc\xc7\xcf\x7e\x00\x8e\x01\x8c\x8d\x97\xf0\xc9\xb6\x8a\xf0\x2b\x71\x81\xe0\x5b\x8c\x33\xcd\x97\xb0\x77\xb9\x51\xf8\x95\xf8\xf7\x8f\xbf\x7d\x52\xf4\xfb\x4f\xff\xbe\xe5\xb4\x3c\x25\x20\x0e\x9e\x89\xa8\x7e\x40\x5b\x42\x1a\x74\x05\xf5\x48\x44\x0f\xd8\x71\x0d\xcb\x19\x07\x61\x9a\xa2\x7e\x0a\xdf\xf3\x64\x6f\xfb\x69\x77\xdd\xbb\x27\x58\xde\x33\x0b\x5f\x3c\xc2\xae\x2d\xbd\x5c\x2c\xaa\x5f\x4c\xe6\x9b\xd8\x40\xdb\xbf\xd2\x89\xbd\xe9\x8d\x5e\x12\xf7\xf9\xf3\xfe\x52\x46\x07\xe1\x0a\xb2\x34\xfe\xd1\x6a\x46\xe8\x4d\xa2\x3f\xba\x08\xe9\x66\x8c\x28\x21\x2d\x8c\x89\x95\x86\x1c\xc2\x91\xa0\x01\x09\x2c\x01\x4c\xc8\x66\xec\x8d\x94\xe8\x8f\xd0\xb3\xa8\x4f\x36\x31\x3e\x5e\x5e\x3e\xbf\x3c\xe3\xcf\x5e\x4c\x71\x83\xdb\x21\x76\x7d\x88\xdb\xfb\x73\xae\xad\xb4\x7c\x7d\xf5\x77\x7f\x8f\xc4\xbd\xe5\xdf\x60\x45\x90\x93\x2c\x6d\xf5\xf2\xb9\xda\xf1\x4a\x30\x7f\xe6\xba\xe0\x61\x87\xfe\x7d\xf1\x9f\x1f\xfc\xff\x2b\xd6\xb1\x72\x44\x97\x5d\x42\x0e\x3f\x88\x13\x21\x96\x10\x7f\x1d\xca\xd5\x43\x57\xf7\x56\xa7\xfc\x63\x58\x45\xc4\xbb\x16\xdc\xdb\x16\x56\xd7\xf0\x0b\xf9\xff\xbd\xfc\x1f\x3e\xf2\xed\xff\x98\x64\x0c\x6e\x20\xf7\x29\xfc\xe0\xd8\x96\x1b\xc9\xdc\xb2\x34\xb2\x40\xbc\x1c\x41\xfd\x20\x12\xd9\xec\xdd\x09\x83\xa0\xa1\x83\x83\x59\x3c\xd0\x44\x68\x84\x6f\x99\x31\x7f\x72\xf0\x02\x07\xf3\x25\x1c\x0e\x30\x34\x59\x13\xbf\x8a\x24\xfe\x25\x24\xb2\x26\xa0\xaf\x62\xa0\xbf\x84\xc1\xb4\x38\xce\x1d\xb2\xef\x23\x79\x1e\xdc\xfe\x98\xd8\x4d\x80\x57\x94\xee\x8a\x9d\x38\xbd\x28\xe2\x05\xda\x50\xbb\xbd\xaf\xe2\x47\x3f\x3f\xe6\x9f\x2c\xf3\x47\xc3\xdf\x88\xf0\xe1\x9d\x79\xe1\x1c\x11\xf6\xde\x5a\xfb\x12\xff\x16\xbe\x3e\xc7\x73\x8d\x86\xf3\xeb\x2a\xfe\x34\x2a\xe8\xaf\x50\x71\xe5\x4a\x8c\x07\x84\x78\x93\xb2\x87\x57\x4f\xbd\x5f\x12\xa6\x20\x13\x9a\xf8\x25\x7c\xfb\x95\x89\x37\xbd\x87\x47\x4c\x46\xfd\x5b\x9e\xc2\x39\xe2\x25\x28\xe9\xe2\x98\x11\xd1\x4f\x8a\x62\x48\x10\x4c\x88\x5f\xbe\xc5\x14\x28\xe0\x6f\x04\x79\x94\xe5\xb9\x30\x2f\xdf\x02\x27\x8f\x88\x10\xe1\x9f\xbc\x73\xa0\xc7\xc0\xe6\xd7\x81\x61\xa4\x9f\xc2\xf2\x6f\xb1\x3c\x05\xf6\x05\xb9\x5f\xb9\xf4\xe3\x81\xdc\x03\x12\x0d\xef\xb3\x04\x05\x60\x29\xf8\xee\x9c\x97\xea\x02\xdd\x8f\x05\x5e\x43\x85\xce\xdf\xfd\x13\xba\x55\xff\xa4\x6e\x4c\x90\x35\xfe\x25\x1c\xf3\x12\xfd\xc3\xbb\xe1\x6f\xde\x42\xe9\x91\xd5\xb5\x0c\xe5\x4b\xc0\x8e\xfa\x85\x22\x6b\xab\xf0\xb7\xc0\x03\x95\x0c\x28\x84\x5f\x89\x23\x73\x7e\x72\xd7\xca\x97\x70\x9c\x75\xc0\x03\x0e\xd3\xe0\xee\xa1\xd8\xfb\xc2\x0a\x3e\x29\xf5\x34\x87\xde\xaf\x97\xb0\xeb\x06\x5e\xed\xe9\xb7\xfa\xc3\xf1\xe9\xd9\xff\x89\xce\xc0\x1f\x9f\xd6\xbd\x57\xd9\xf0\x36\x7e\xec\x9d\x12\x59\x81\x2f\xe1\x67\x4e\xfc\xdd\x3f\xec\x77\xd3\x08\x68\xd0\x71\x71\xdd\x9b\x96\xf7\x2e\xc0\x79\x14\xc7\x06\xe8\x72\x47\x4d\x18\x24\x7d\xdf\xac\x86\x01\x35\xef\x05\x77\x06\x34\x63\xfe\xf7\x3b\x85\xdd\x31\x53\xe6\x06\x5e\xb1\x8a\x66\xfa\xb5\xce\x12\x6f\x85\x41\xb1\x1f\xbd\x79\xf6\x97\xf0\x49\x0b\x5d\x7b\xd5\xe1\x4d\x19\xfe\xf5\xfa\x6b\xcb\xa6\x8c\xfd\x43\xa9\xfe\x11\x8f\xdb\x1a\xfc\x75\xd0\xd0\x89\x1a\xc0\x39\xf0\xfa\x08\x41\x50\xee\xcb\xf6\xe1\x80\xc8\x80\xa6\x8e\x34\xf3\x31\x2b\x2c\xe2\xb7\xcf\xa3\x79\x68\x08\xfe\x48\x3c\x70\xda\x11\x1e\xc6\x65\xd7\xce\xa8\xff\xd9\x01\xc2\x41\xe3\x9e\xd8\xba\x76\x2f\x44\xb8\x7e\x18\xfc\x96\xbe\xbb\x2e\x6a\x70\x8a\x5b\xd6\x38\x03\x02\x13\x9a\x43\xc8\x59\x86\x8c\xb7\x4f\xb9\xaa\xc1\xa1\xfb\xa7\x5c\xd5\x23\x64\x3c\xfc\x7e\x64\x8f\xdc\xfb\xbb\xf1\x66\xf8\x5a\xb5\xef\xed\x4b\x47\x27\xca\x9e\xdd\x6f\xfa\xbf\x10\x5f\x1e\x73\x73\xcc\x0c\x87\x34\x13\xef\x0f\x7f\x10\xef\xc4\x6f\xbf\xc5\x7e\x3f\xde\xc1\xe1\xe7\x07\xcb\x78\xbf\xc6\xe0\x06\x43\x8d\x7f\xb9\x7a\xb6\xe8\x95\xf8\x8d\xe0\x2c\xc3\x80\x1a\xf6\x6e\xb9\xcd\x11\x8e\xac\xf1\xc8\x89\x29\x88\xf3\xe6\xd4\xbc\xad\x6f\xa7\x1e\x9e\x0f\xde\xbf\xd1\x35\x58\x57\x9b\x58\xd0\xbf\x24\xf7\x7c\xfc\xf2\x4a\xb9\x92\xb9\x64\xfc\x37\x42\x07\x58\xca\x11\x61\x32\xfc\xea\x7a\xe4\xc0\x74\xbf\xeb\x97\x2f\x5e\x0b\xbf\x12\x87\xa6\xcb\x3d\x77\xf2\xe2\xdb\xeb\x41\xe2\x37\x77\x9a\xde\x39\x69\x42\xfc\x7e\x75\x4c\xfc\xa4\x58\x3f\x79\x7b\xdb\x33\x04\x7e\x9e\x55\x38\xa7\xed\x98\x94\x67\x31\xfb\xdd\xf2\x2e\xde\xf3\x6d\xd5\x7f\x06\x5a\x7f\xb9\xf4\x1e\xd6\xcf\xdd\xc7\x77\xf1\xbd\xfe\x85\xad\xe2\x1d\x63\xbb\x2f\x1a\xb7\xc4\x5f\x44\xe4\xeb\xfe\x54\x9d\x57\xc6\xfb\xfe\x88\xee\xff\xbe\x4b\xec\xc9\x24\xe8\xb7\x73\x8b\xf7\xaf\x5b\x36\xc2\x06\x06\x01\x74\xfd\x53\x3f\xcf\x35\xd3\xdb\x00\xf3\x03\xd0\xf5\x0b\x83\xe6\xb9\x99\xde\x6a\xf8\xf7\x58\x3a\xdf\x2e\xe4\x82\xcf\x33\x83\x4c\x10\xd7\x8e\x49\x1e\x1d\xf6\xf4\x9c\x07\x42\x00\x3c\x0c\x11\x18\xb0\xde\xbd\xb5\xef\xa1\x28\xbd\x3f\xdd\xc9\xcb\x40\x41\xe2\xb5\xbb\x4a\xfd\xf3\xd6\x67\x91\xde\x8d\x43\xa7\xbe\x4f\xe8\xc3\xf2\xbd\x97\xe8\x46\xb9\xb8\x37\xf5\xa2\x82\x1b\x07\x43\xed\xe2\x82\xd5\xeb\x85\xfd\xf1\xf2\xe6\x9b\x11\x3f\x2f\x87\x3a\x72\x50\x43\xe7\xb7\x40\x9d\x54\x09\xce\x38\x9f\xbe\xa4\xf5\x70\x93\x0c\x3a\xbc\x9b\x95\x97\x4d\x55\x3e\x40\x3e\x7d\xaf\x6a\xd1\x2b\x77\xef\x0d\x93\xde\xb5\x52\x57\xae\x82\xfd\x2f\x6f\x25\xf8\xe7\xab\x37\xc2\x1e\x2a\x9f\x1e\x7c\x3e\xcd\xba\xf1\x92\xc9\x0b\xc1\x9d\x5f\xcb\x75\x52\x7a\x7f\x6f\xd3\xcd\xbb\xa6\xce\x22\x6c\xff\xad\x81\x37\xae\x5d\x0d\xf9\x17\x8b\x86\xfc\x37\x77\x84\x08\xf2\xe3\xd6\x2b\x28\x9f\xa5\xfd\xf2\xa6\xa9\xa7\x5b\x70\x7f\xe0\xfc\x30\xdb\x77\xbd\x35\x3f\xbc\x16\xfc\xa2\xa0\xef\x9d\x66\x3e\xbd\x96\xf9\x4f\xd4\xc5\x93\x40\xfb\xff\x29\xe2\xff\x65\x8a\x28\x31\x1f\x83\x20\x7e\x24\x82\x28\x2b\x77\x76\xcc\xff\xa4\xfc\xc9\x85\x60\x97\xa1\x9c\x2b\xf0\xf3\xeb\x90\xbe\x4e\xf1\x03\xf5\x7b\x68\x3c\xce\x2f\x7d\xb8\x08\xf7\x6f\xdd\xac\xf6\xc7\x11\x5d\x0d\xfe\x83\xdb\xe4\x06\xc0\xd9\xcb\xf8\x2f\x41\x7a\x36\x11\x70\x84\x75\xdf\xc4\x77\xd0\xfe\x27\xdb\xb4\x37\x32\xb8\xbb\xcc\x7b\x15\x3b\x56\x95\x8f\xff\x3f\x00\x00\xff\xff\x14\x70\x35\x6c\xf1\x8f\x00\x00")
+var _staticReportTemplateHTML = []byte("\x1f\x8b\x08\x08\x00\x00\x00\x00\x02\xff\x72\x65\x70\x6f\x72\x74\x5f\x74\x65\x6d\x70\x6c\x61\x74\x65\x2e\x68\x74\x6d\x6c\x00\xed\x7d\xe7\x9a\xe3\x48\x8e\xe0\xff\x7e\x0a\x4e\xf6\xcc\x28\x73\x99\x12\x49\x51\x86\xca\xaa\xcc\x1b\x79\xef\xbd\x7a\xfb\x66\xe8\x49\x89\x4e\xb4\x92\x6a\xeb\xdd\x2f\x82\xa4\xbc\xcd\xea\xea\xdd\xf9\xf6\x3b\x75\x57\x8a\x0a\x83\x00\x10\x00\x02\x61\x10\xfc\xfa\x17\x4e\x67\xed\xb5\xc1\x23\x92\xad\x2a\x1f\xbf\x7c\x85\x5f\x88\x42\x6b\xe2\xfb\x13\xaf\x3d\x7d\xfc\x02\x52\x78\x9a\xfb\xf8\x05\x01\x9f\xaf\x2a\x6f\xd3\x08\x2b\xd1\xa6\xc5\xdb\xef\x4f\x8e\x2d\x44\xa9\xa7\xc3\x2c\x8d\x56\xf9\xf7\x27\x57\xe6\x3d\x43\x37\xed\x27\x84\xd5\x35\x9b\xd7\x40\x51\x4f\xe6\x6c\xe9\x9d\xe3\x5d\x99\xe5\xa3\xfe\x8f\x57\x44\xd6\x64\x5b\xa6\x95\xa8\xc5\xd2\x0a\xff\x4e\xbc\x22\x96\x64\xca\xda\x22\x6a\xeb\x51\x41\xb6\xdf\x35\xfd\x02\x68\x8e\xb7\x58\x53\x36\x6c\x59\xd7\x0e\xa0\x67\x97\x0e\x6d\xc9\xca\x1a\xe9\xf1\x7e\xbb\xe7\xf5\x68\xc7\x96\x74\xf3\xa0\xca\x28\xa8\x50\xa7\x65\x8b\x37\x91\x67\xc9\xb6\x0d\xeb\x0d\xc3\x6c\x4f\xb6\x79\x33\xc6\xea\x2a\xe6\xfa\x25\x82\x02\x2f\x17\x40\x8a\xbc\xc6\x9b\xb4\x7d\x04\x75\x87\xc8\xb7\x6f\xb1\x11\x6f\x5a\x00\xcd\xef\xdf\x2f\xd4\x35\x75\x46\xb7\xad\x83\x8a\x9a\x2e\x6b\x1c\xbf\x7a\x45\x34\x5d\xd0\x15\x45\xf7\xb6\x95\x6c\xd9\x56\xf8\x8f\x13\x02\xbf\x62\x41\x72\x50\x44\x01\x4c\x43\x4c\x5e\x79\x7f\xb2\xec\xb5\xc2\x5b\x12\xcf\x03\xd6\x4b\x26\x2f\xbc\x3f\x6d\xe9\xb2\x6c\x9a\x5d\x18\xb4\x2d\xc5\x18\x1d\xb4\x6c\x9b\xb4\xc1\x72\x9a\x4f\xe7\x2e\x01\x4b\xc4\xc8\x18\x81\xb1\x96\xb5\x4f\x8b\xa9\x32\x28\x65\x59\x4f\x7e\x53\xf0\x23\x03\x8c\x45\x53\xb6\xd7\xa0\x39\x89\x26\xa9\x44\x54\x14\xdb\xeb\x1e\x2e\x4f\xf2\x4c\xb3\xeb\x92\x13\xd9\x50\x69\x32\xd1\x2c\xa0\x5c\x05\x23\x84\x6e\x9a\x4a\x60\xf3\x14\x3b\xc5\xe4\xda\xa0\x3b\x6c\x4b\xec\xd8\x4c\xaf\x32\x35\x57\xef\xad\x06\xf1\xe6\xcc\x23\x06\x80\x0d\xa6\x6e\x59\xba\x29\x8b\xb2\x06\xba\x4a\xd3\xb5\xb5\xaa\x3b\xd6\xd3\x27\xe8\x83\xc4\xcc\x2d\x8e\x57\x64\xd7\x8c\x69\xbc\x8d\x69\x06\xe8\x41\xd9\x9a\x5b\x51\xf0\xcb\xd3\xcd\xc5\x3f\x12\xb1\x78\x22\x96\xc6\x38\xd9\xb2\x61\xce\x23\x94\x49\x6e\xaa\x3f\xc8\x96\x9d\x45\x62\x39\xf0\x54\x73\x5d\x62\x66\xb3\x81\x46\x76\xcd\x72\x6f\x3d\x1b\x13\x96\x9e\xcf\xd4\xb1\xc2\x3a\x45\x6d\x2c\xca\x72\x98\x5c\xa9\x3d\x4c\x65\x6c\x11\x2b\x97\x67\xc2\xa2\x9a\x63\xee\x51\xe6\xd3\x83\x40\xed\x7b\x7f\xb2\xf9\x95\x0d\x79\x1f\xe6\xc1\x8f\x00\x7a\x01\x08\xe7\xb7\x5d\x02\xfc\x30\xba\xc9\xf1\x26\x50\x12\xe3\x0d\x21\x8c\x15\x62\xe9\x8a\xcc\x21\xa6\xc8\xd0\xcf\xf8\x2b\x12\xfc\x1f\x23\xe2\xc9\x97\x2f\x47\xd5\x54\xda\x04\x38\x04\xd5\x92\xb8\xb1\x3a\xce\x35\x68\x8e\x93\x35\xf1\x52\x16\xc4\x2b\x4a\x2b\xb2\xa8\xbd\x21\x2c\x90\x55\xde\x3c\xce\x17\x80\x08\x47\x2d\x79\xc3\x03\x74\xe2\xa7\x95\x59\x5d\xd1\xcd\x37\x88\xdd\x73\x8a\x7a\x45\x82\x7f\x07\x98\x7d\xf7\x9f\x4e\x09\xa6\x4f\x48\x0e\xa1\xc8\x9a\xc4\x83\xee\x41\xfe\x22\xab\x50\x09\x68\xcd\xbe\x80\x29\xc7\xb3\x3a\xd0\x4a\xa0\x78\x6f\x88\x03\x54\xca\x04\xd2\xc3\x5f\x6d\x30\xc6\xd2\x26\xe8\x0f\x5e\x39\x69\x31\xe4\x16\x50\x52\x5b\x57\x4f\xb9\x72\x0d\x46\x14\x98\x0e\xf5\x32\xea\xbf\x92\x14\xc9\x25\x88\xc7\x39\x7b\xbb\x8d\x98\x41\x8b\x7c\x14\xa4\x71\x27\xcd\xf9\x96\xf5\x0d\x21\xf1\x9b\xdd\xa8\xf0\x82\x7d\x49\x3a\xde\x90\x78\x12\x48\x14\x01\x2a\x23\xc9\xed\xd3\x71\x41\xa0\x3d\x86\x42\xaf\x61\x67\x40\xc6\x46\x19\x45\x67\x17\xd7\xd1\xb6\x80\x50\x29\x7c\x34\x40\x17\x08\x0a\x0d\xea\x98\x07\xe8\xbf\x3e\x56\x14\x8e\x40\xc0\x9a\x46\x6d\x9a\x01\xfa\xf2\xed\x22\xea\x10\x69\x1f\xf1\xf0\xe1\x3a\x52\x3e\x48\x30\x8c\xf0\xbc\x66\x49\xba\x7d\xd0\xda\x31\x64\x43\xb7\xe4\x40\x90\x80\xf1\x01\x22\xe5\xf2\xc7\xbc\xd0\x5d\xde\x14\x80\xa9\x7e\x43\x24\x99\xe3\x78\xed\xcb\x25\x3d\xdd\x8a\xd0\x83\xaa\x7a\x07\xd7\x13\x0c\x81\x85\xd6\xb6\x38\xfa\xcf\x82\x6e\x02\xe9\x48\x5a\x08\x4f\x5b\x7c\x54\x77\x4e\xba\x99\x75\x4c\x0b\x8a\xe3\x46\xd7\xd5\xa8\x7c\x82\x70\x28\x3b\x04\x8e\xff\xed\x01\x39\x84\x4c\x33\x75\x25\x6a\x98\xbc\xfb\x7a\x23\x5f\x03\x72\x77\x59\x48\x93\x9f\x6d\x26\x2a\x83\x5f\xa7\xd6\x10\x0c\x6b\x22\x28\xab\x71\x51\x59\x05\xbc\x02\x4a\x6f\x2a\xcf\x4f\x1c\x6d\xd3\x6f\x7e\x02\x66\xb9\x22\xba\x52\x95\xd7\xbf\x91\x2c\x78\x44\xc0\xa3\x66\xbd\x47\xe0\xb8\x01\x86\x0d\xcf\xf3\x62\x1e\x19\xd3\x4d\x11\x8b\xe3\x38\x0e\x0b\x47\x10\x41\x56\x94\xf7\xc8\xdf\xe2\x64\x8a\x4d\x27\xd3\x5c\x04\x81\xfe\x4c\x4e\x5f\xbd\x47\x70\x04\x47\x28\x84\x8a\xfc\x8d\xe4\x01\x38\x38\x9c\x22\xdc\x7b\xa4\x99\x8c\xc5\x93\x08\xae\x44\x13\x48\xf0\x1f\x11\x4b\x46\xe1\xbf\x78\xf0\x0f\x09\xbf\xa3\x61\xfa\x26\x82\x05\x00\x60\x73\xe0\xe9\xe9\xe5\x13\x8c\x80\xfc\xfc\xb7\x65\x44\x3c\x96\xf6\x19\x01\x88\x84\x4c\x40\x0e\x88\xf7\x9f\xb7\xe9\x89\xa8\xff\xdf\x0f\x31\x02\xf8\x49\x32\x0b\xdd\x2e\x0b\x51\xe4\xeb\x4c\xd8\x1a\xde\x00\xf5\xeb\x70\x19\x9a\x13\x2f\x9b\x94\x28\x18\xb5\x25\x1b\xc8\xe9\x5d\x5b\x62\xf2\x9c\x6c\xf2\xac\x1d\x95\x74\xe3\xd4\xfe\x87\x1a\x67\xe8\xf2\x6d\x03\x7f\xd7\xce\x3d\xa4\x9e\x17\xa0\xd8\xa7\x63\x84\x3f\x60\x0b\xb4\x0a\xdc\xc9\x37\x24\xbb\x75\x49\x90\x8e\xa9\xbf\x22\x79\x5d\x03\x46\x8a\xb6\x5e\x91\x26\xaf\x29\x20\xa1\xa9\x6b\x34\x0b\xbe\x1b\x0e\x2b\x73\x74\x98\xcf\x83\xdf\x32\xc3\x07\xc3\x2c\x2c\x02\x0a\x14\xf8\x39\x3d\x72\x90\x3e\x30\x42\x61\x4a\x4e\x86\x2e\x24\x4f\xab\x08\xf0\x84\xe9\xc3\x9c\xbc\xee\x98\x32\x30\xb7\x2d\xde\x7b\x45\x54\x90\x64\x19\x34\x0b\x80\x02\x47\x5b\x16\x3e\x49\x5c\x2c\x48\x88\xba\xb4\xe2\x9c\xb1\x0c\x98\xe0\x28\x03\x50\x58\xbc\x21\xfe\x17\x18\x00\x95\xcf\x0e\x56\xdf\x7e\x82\x55\xff\xb4\x4b\x21\x02\xd7\x5b\xfa\xe1\x81\xe9\xa2\xa8\xc0\x8f\xc4\x07\x22\x9d\x3e\xf7\x0f\x0e\xfd\xc3\xf8\x59\x6e\x40\xf6\x0f\x8f\x62\x3e\x39\x57\x89\xa0\x19\x00\xd2\xb1\x4f\x88\xf0\x31\xc1\x8f\xd3\xa0\xe3\x72\x96\x78\x97\xda\xdb\x2a\x13\xb0\x5a\xd1\x69\xe8\x00\x47\xe1\x98\x0e\xfc\x9b\x7f\x13\x5c\xe1\x67\x13\xf5\x67\x86\x6f\x48\x06\x7c\xbe\xdc\x33\x77\x82\xff\xf9\x9c\x07\x1f\x3a\xff\x61\xdf\x27\x3f\xcd\xab\x98\x61\xea\xa2\xc9\x5b\xd6\x65\x33\x1a\x30\x02\x4c\xc0\xf5\x2f\x37\xac\xec\x79\xfe\xd6\x4d\xb8\xc6\x2a\xf2\xa6\x61\x06\xde\x92\x17\x55\x75\x13\xb8\xa8\x0e\xd0\x38\xed\x32\x6e\x57\xa6\x43\x77\x74\xf5\x60\xf4\xb0\x35\xe0\x9a\x80\xf1\xd5\x5c\xc7\x80\x6b\x0c\x6d\x12\xf7\x7a\x94\xfc\xb6\x4d\xbe\x3b\x54\x01\xe6\xae\xaf\xd2\xf3\xeb\xde\x07\x6c\xea\x1c\xad\xdc\xf3\x0c\xaf\x0a\xda\x9d\x01\xe9\x2b\xe6\xcf\x49\x3f\x7e\xf9\x8a\x05\xcb\x3e\xbf\x7c\x65\x74\x6e\x1d\xce\x57\x35\xda\x45\x58\x30\x3c\x58\xef\x4f\xe0\x91\xa1\x4d\x24\xf8\x8a\xf2\x2b\x83\x06\x94\xa8\xdc\x36\x81\xa3\xcd\x05\xc2\x88\xfe\xf7\xc1\x8c\xf6\x2b\x7d\x5c\x1f\x58\x67\x50\x6f\x3b\x99\xff\xf5\xe9\x23\xdb\x1d\x66\xfb\xd5\xc6\xf4\x2b\x46\x1f\xd4\x0a\xbb\xf0\xb8\xaa\xad\x8b\xc0\x5c\x9b\x4f\xe1\xfc\x39\x28\xf3\x84\x40\x8f\x27\xcc\x7b\x7f\x02\xac\x55\x68\xc3\xe2\xb7\xc9\xa0\x5f\xe1\xc2\xd5\xaf\x01\x08\x30\xc6\x39\x4f\x47\xdc\xa1\x4d\x99\xde\xba\x5a\xd6\x71\xb9\x20\x2f\x20\x94\xe7\xde\x9f\x04\x5a\x81\x70\xfd\x54\x85\x66\xe0\xf2\xc4\xc0\x6f\x15\xb2\x40\x16\xfd\xb1\xf1\x80\xf2\x60\xbe\x0f\x2a\x5f\xa6\xc2\x77\xe9\x9e\x3e\x00\xfb\x41\x91\x03\xca\xb1\x80\xac\x8f\xbd\xcc\x7d\xe5\xe4\x5d\x27\x6c\xc9\xdb\x72\x7d\x4f\xae\xcc\x6d\x5b\xf0\x91\x3f\xc1\xc3\x51\x4e\xb0\x80\x1d\xab\x9a\x51\xa8\x84\x27\x65\xc3\x15\x98\x83\xf2\xc1\x14\x94\x33\x75\x83\xd3\x3d\xed\x42\xf1\xb3\x8e\x8e\xfa\x2b\x38\xdb\x1a\x21\xc9\xfb\x4e\xf7\x91\x85\xe2\x6c\x15\xb6\x40\x11\xc0\xff\x6b\x7d\xba\x6b\xf9\x62\xc3\xbb\x5e\x94\x68\xcb\xd0\x0d\xc7\x78\x7f\xb2\x4d\x87\xbf\xd2\x7d\x1f\x57\x61\x74\x20\x3e\x97\x49\x3b\x14\xcd\xa3\x8c\x83\x9e\xd9\x11\xab\xee\x65\xc7\x97\x12\x60\x09\x98\xf5\x29\xb9\xd7\xd1\xd8\xf3\x71\x07\x11\xb2\x7f\xc7\x3c\xcc\x07\x84\x31\xeb\xa8\x25\x03\x8f\x8e\x86\xcb\x57\x4f\x1f\xb9\x35\xd2\xdf\xfd\xbc\x8a\xef\xe7\xe0\x4b\xba\x65\x5b\x3e\xe8\x0a\x7c\xfa\x19\x50\x03\x87\xeb\xe9\xa3\xef\x7f\x07\x2c\xbf\xce\x5d\x0c\xb0\xf7\x82\x6c\x62\x8a\xfc\x90\xc4\x3e\x2c\xa8\xa7\x58\xfa\x83\xde\xd3\x47\x19\x7e\x5d\xc4\xee\x1c\x85\xaf\x98\xa3\x1c\x2a\xf1\x0e\xf3\xaf\x18\x68\x25\x54\xe6\xaf\x2a\xf0\xed\x42\x41\x87\x8f\x4f\x7b\xad\x0e\xdd\xbe\x40\x33\x68\xc3\x38\xc0\xfd\xdb\x37\x59\x40\x62\x55\x8d\xd5\x55\x43\xe1\x6d\xfe\xfb\xf7\x43\xb1\xa3\x81\x29\xb1\x11\xff\x6f\xd4\xa3\x4d\x0d\x30\x16\x51\xed\x28\xb9\x55\x28\x3f\xe7\xe9\x63\x20\xc9\x16\x62\xb1\xc0\x18\x79\xb4\xe5\xaf\x7b\x9a\xa6\x63\xd8\x70\xec\xb2\x25\x1e\x78\x95\x70\x7d\x0d\x91\x61\xd6\xb6\x9d\x58\x40\xc5\xb7\x6f\xbc\xc6\x7d\xff\xbe\xa7\x0d\x0c\x61\x36\x74\xbf\xc1\xcc\x10\x58\xaf\xc3\x5f\x21\xc1\x90\xb4\x2d\xc5\xe1\xfa\x1e\xa4\x2a\x78\x3c\x1c\x1a\x8c\x2d\x11\xbe\xbf\xa2\x02\x40\xdc\x7e\x3c\x38\x5a\x50\x47\xfe\xae\xca\x1c\xa7\xdb\x5f\xc0\x40\xcd\xf1\x60\xac\x03\xd3\x4f\xdf\xb6\x9e\x75\x8d\x3f\x98\xf9\x76\x12\x8c\x79\x60\x9a\xf6\xc5\x9f\xfc\x78\x81\x07\xc1\xe8\x0a\x68\xe1\xef\xbf\x82\x41\x92\x4a\x7c\x09\x4d\x2f\xc2\xac\xa1\x4c\x1c\xaf\x2e\xc3\x5d\x81\x4b\xdb\x02\xe7\x26\x68\x3b\xc2\xfc\x93\x51\x68\x20\x4a\x1f\xc1\x36\xc3\x59\xb1\xa0\x3a\x94\xa6\xaf\x98\x71\xc8\x83\x8f\xb3\xb6\xe1\xcc\x9c\x71\xd6\x2a\x0f\xe6\x61\x82\xc0\xf3\x3e\x12\xaa\xcc\x4a\xbc\x66\xca\x0b\x8b\x07\x72\x73\xda\xe8\x57\x59\x15\x2f\x0a\xbb\x65\xb2\xef\x87\x4b\x02\x86\x26\x7e\x61\x68\x8b\x4f\x25\x5e\xe5\x51\xae\xdd\xf3\xf0\x7a\x59\xd4\xb3\xe0\xd3\xea\x0f\xa5\xe2\x50\x04\x4f\x75\xff\xb7\x92\xcf\x4e\xc1\x57\xa1\xbf\xa8\xd4\x3b\x30\xa1\x3c\xe9\x95\xc6\x95\xde\x80\x89\xcf\x70\x2e\x5e\x5a\xcf\xba\xb9\xdc\xac\x9c\x91\x67\xfd\x5c\x8d\x19\x97\xb4\xd9\xa8\xa6\x4c\xc7\xbd\x24\xcb\x2a\x0a\xac\x90\x6f\xe7\x6a\xbd\x62\x69\xc8\xb7\x4c\x6b\xd2\xcc\x74\x46\x45\x96\xd5\x08\x7c\x54\x2b\xc7\x47\xab\xc2\xc0\xee\x0f\x84\xa2\x51\xe5\xca\x63\x3e\x59\x4e\x70\x75\xbc\x86\x15\x85\x65\xab\x30\x6d\xa2\x75\x82\x66\xf3\x58\xb6\xb8\x76\x6b\xcb\x7c\x25\xa3\x56\xf3\x9a\x6d\x14\x16\xd4\xc8\xa3\x35\x43\x9c\xe3\x44\x33\x9b\x9a\xc6\x3b\x53\xb5\x6a\x58\x56\xbd\x69\x90\x1d\xaf\x2d\xac\xc8\x71\x85\x8f\x63\x7c\xdc\xa1\x6c\x53\x1d\x52\xeb\xf1\x84\xe1\xb1\xce\xbc\xcd\xa5\xd3\x1b\x6c\x30\xee\x34\xfa\x62\xc7\x6e\xd1\xf3\xe4\xb2\x6d\x65\xc5\x7a\x3b\x67\x8f\xf2\x3a\x93\xd5\xeb\xde\xb2\x2d\x66\x53\xcc\x7c\xa3\x0c\xfa\x7a\x69\x92\x1d\xf2\xcd\xd6\xa8\x53\x9e\xb3\x59\xa7\xd5\x95\x97\x45\xae\xbe\x12\xfa\xc5\x56\xbe\x29\x0e\xaa\xf5\xcd\x26\x47\x97\x6a\xf5\x44\x51\xcb\x0e\xb4\x52\x3e\x3b\x22\x5a\xb3\x79\x5a\x2c\xac\xd3\x59\x76\x92\xf1\xf2\x8b\x2a\x3d\xcc\xf3\xc3\x81\x39\x5b\xf3\x73\x34\xce\xb4\x34\x7b\x39\xc8\x49\x5d\x6b\xc2\x64\x17\x55\xaa\x5d\x5a\xd4\x3c\x1e\xe3\x78\x67\x1c\xb7\xe7\xd3\x61\x87\xcc\x60\xac\x92\x12\xc6\x44\x6b\xc2\xd8\xf1\x01\x17\xc7\x04\x28\x01\xa9\xb8\xe2\xb2\xd8\xc0\x8b\x97\xc9\xf9\xbc\xdd\x4c\xcd\xb0\x71\x65\x98\x27\xc6\xf6\x58\x1b\x18\x64\xbf\x27\xca\x8c\xbd\x18\x32\x4c\xc6\xb5\x47\x34\x89\xd5\x73\x56\xc7\x51\x30\x13\xd5\xf5\x76\xbb\x91\xd4\x1d\x7c\xc6\x8d\x15\xa3\x3f\x48\x26\xa8\x21\xeb\x36\xd6\x19\x1a\x34\xb5\x49\x34\x4b\x43\x8c\x6e\xe1\x69\x0e\x4d\xe9\xeb\x24\xeb\x8e\x51\x3c\xd5\x29\x7b\xe0\x4f\x53\x32\x26\x53\x32\x23\x99\x62\xda\x2b\x72\xad\xa2\xe5\x61\x3c\x9e\x93\x2a\x3d\x54\x50\x12\xad\x42\x76\xad\x53\xa8\xd0\x19\x53\xa5\x96\x88\x3b\x93\x86\xb2\x20\xb3\x13\x3c\x57\x4f\x89\xc2\x46\xd6\x88\xa9\x52\x37\xb4\xc1\x58\xd9\x58\xf1\x22\xd9\x5d\xe6\xe3\xce\xb4\x6b\x8e\x7a\xfd\x51\x2a\xc3\x33\xb4\xe6\xa6\x9d\xb4\xe3\xcd\x04\xb2\x27\x52\x78\x4a\xe4\xe6\x96\x90\xb0\x65\x69\x62\x89\x8d\x69\x5e\xb6\xda\x09\xb6\xca\x25\xf2\x64\x72\xa3\x91\x4d\x77\x59\xb2\x99\x71\xdc\x48\xf3\x84\x35\xca\x8b\x93\x11\x91\xe1\x01\xcd\x5e\x62\xca\xdb\x92\xbd\x2c\x8e\x96\x69\xca\x59\xba\x8d\x12\xed\xea\x39\x6c\x33\x73\xba\xd4\xd0\x9b\xd2\xdc\x62\x95\x10\xbb\xd5\x54\xa1\x88\x76\xe4\x04\xc1\x2d\xe7\x7a\xaa\x3d\xb6\xd8\x41\x4b\xdd\x08\xa3\x78\x4b\x9a\x2e\x1a\x33\x4c\x64\xb5\x5a\x9f\x71\x26\x2c\xd9\xda\x14\x18\x8f\x2d\x4b\xcb\xb5\x5b\xa0\x9d\x69\x3a\x51\xb2\x47\x29\x77\x49\x2c\x6d\x60\x09\x4b\xba\x3d\xce\xb6\x37\x56\x7a\x38\xee\x77\x70\x82\x75\x14\x62\x92\xc4\xc9\x04\x91\x19\x0d\xcb\xdd\x49\x1c\x1d\x65\xa6\x68\xd9\x4a\x2d\x2a\x7d\x95\x95\x13\x4e\x43\x22\x57\x4a\xa7\x61\x67\x50\x92\xee\x3a\xb9\x59\x6e\xd3\x5f\xe4\x0a\x7d\x6b\xd4\x35\xb9\x2e\x53\x9f\x0c\xe2\x69\xce\x4d\xf3\xfc\xac\x19\xe7\x86\x4c\x1c\x75\x3b\x23\xcd\x25\xcd\x78\x43\x5b\xb4\xba\x04\x96\x6e\xb6\xeb\xf3\xde\xb2\x35\xd1\xe2\x2c\x5e\x2b\x67\xb9\xe6\x00\x47\xcd\xfe\x72\x2c\x8f\x14\x6e\xa2\x67\x5a\x58\x3a\x93\xca\x54\xcb\x84\x5d\x2c\xf5\x93\xb5\xd5\xa0\xcf\x18\x66\x46\x11\xc7\x84\x91\x12\x2a\x82\x99\x44\x31\x4e\xaf\x37\x58\x0f\x1b\x0c\x28\xaf\x5d\x90\x13\x36\x25\xa3\x85\x4a\x7a\x6e\xa8\x95\xa6\xa3\xea\x38\xba\x5a\x78\xad\xc1\x48\x69\x0d\x8a\xd3\x76\xa1\xb8\xc2\xd9\xc2\x90\x51\x13\x56\x8b\x51\x4d\x72\x42\xd2\x32\x8b\x39\xa4\x89\x33\x40\xa1\x39\xaa\xd0\xd2\x66\x71\xc1\xae\x14\x35\xca\x2b\x34\x49\xaa\x33\xe9\x69\xed\xbe\xd0\x94\xe6\xe5\x49\xa9\x2b\xe6\xf2\x1e\x9f\x52\xc8\x86\xb2\x5a\xda\xc9\x52\xb9\xe5\x70\x1c\xa0\x65\xd3\x4b\xa1\xae\x19\x97\xf2\xda\x9c\xc9\x95\x37\x44\x0a\x15\xea\x8a\x36\x53\x19\xd1\x6d\xcf\xeb\x7a\xba\xee\x08\x75\xac\xaf\x8c\xd1\x61\x7a\xdc\xa1\xaa\x03\xbb\x5c\x5e\x66\x39\x54\x92\xd5\x16\x60\x11\x1b\xc7\xcc\x39\x97\x59\xba\x2b\xa0\xa1\x69\x74\xae\xcd\x73\x34\x99\x99\xce\x0a\xe3\x4d\xc5\x9b\xb0\xc3\x52\x2a\xa7\x4d\xc7\x95\x5c\x7b\x83\xa5\xa6\x6a\x6a\xbe\x19\xe3\xe9\x79\x95\x93\xc9\x7c\x3e\x63\x99\xd5\x7e\x67\xcc\x66\xd0\x76\xbd\xbd\x19\xb3\x7a\x39\xcf\x19\x26\x3f\x15\x7b\x6a\x7c\xd5\x32\x07\x95\x4e\x51\xc9\x38\xc5\xf4\x3a\x3f\xe8\xf6\x12\x55\x67\x51\xf0\x26\xf6\x7a\x82\x8d\xd7\x02\x99\xd5\xea\x62\xa1\x31\x54\x36\x62\x97\x67\xd7\x84\x9c\x90\xe6\x9a\x8c\xd6\xd4\xa2\x2d\x0b\x94\x37\x90\x6a\xa3\xbc\xa5\x98\x74\xae\x9f\x6d\x16\x45\x2c\x8b\xab\x7d\x95\x96\x06\xf3\xfa\x44\x14\xad\xb2\x25\x92\x7a\x92\x2d\xad\x73\xa3\x94\x53\x1b\x2b\x28\x53\x5d\xa6\x73\xba\xa7\xe4\xa6\x4e\x49\x4d\xb0\x84\x25\xa1\xa5\x15\x47\x50\x79\x2e\x33\x65\x17\x38\x3a\x2c\xe6\xa8\x4e\xbe\x62\xbb\x62\x0d\x5d\xb7\xd9\x7e\xb2\x3e\xa4\x32\xd9\x5c\x52\x2e\x8c\x56\x93\x81\x5c\x65\xa5\xb5\x53\x24\x7b\x4a\x8f\xa9\x70\x86\xc8\xa0\xf5\x71\x36\x3e\xe6\x71\x41\x6a\x75\x4b\x1d\x79\xd6\xec\x9b\x4d\x73\x94\x44\x85\xf6\xbc\xba\x9e\xba\xc4\x90\x9e\x54\xf9\x4e\x45\xec\xaa\x23\x4e\xad\xb5\x7b\xe4\x26\xdb\x4a\x2d\x04\xab\xb4\x28\xa8\x5d\xbd\x8a\x35\x5a\x8c\x22\xe2\x45\x7e\x20\xbb\xc9\x69\x2e\x33\xcb\xb6\xbc\xdc\xa6\x5c\x2f\x37\x57\xcb\x82\x21\x65\x95\x62\x27\xdd\x25\xca\xf2\x6c\x25\x0c\xf2\x9a\x91\x5b\xf4\xda\x15\xa9\x51\x6b\x28\xf5\x56\xa3\x55\x96\x1b\x9b\x59\xd1\xae\x35\xe3\x56\x16\x4b\x74\x2a\xf3\x15\x51\x4c\x73\x6b\xac\x3a\x01\x42\xec\x36\x67\x6c\xa1\x5c\xe8\x49\x6a\x53\x62\xc4\x82\xed\x9a\x09\x8e\x22\xca\x4c\xb6\x67\x4d\x93\xc9\x26\x28\x29\x5a\x03\x73\xc9\x66\xc9\x76\x1e\xef\x4b\x62\xa9\x26\xe7\x0a\xd3\x19\xd6\x73\x66\xeb\xee\x5a\x9e\x62\xc5\x84\x24\x96\x29\x1b\xeb\x13\x0e\xd7\xd2\xad\x5c\x76\x94\xb7\x65\xd6\x4e\x3b\x74\x37\xa7\x7a\x62\x6b\xd3\x71\xba\xcd\x79\xab\x67\x94\xd1\x99\xb4\xb2\x33\xb5\xe1\xaa\x41\x12\x24\x26\x12\xa8\x58\x11\x12\x05\xa7\x28\x31\x1c\xef\x4e\x36\xd4\xb0\xd5\x58\xe0\x2b\x41\x4d\x26\x0b\x95\xb2\x91\x46\x5b\xee\x72\x53\x89\x17\x36\x89\x85\x45\x71\x99\x11\xc0\x89\xd6\x33\x6b\x0e\xad\x67\x29\xaf\x86\x66\x26\x26\xc7\xc4\x93\x0e\xa7\x89\x58\x7a\x29\x96\x85\x46\xab\x27\x64\x3a\xea\x3c\x9e\xaf\xe9\xf3\xcc\xa4\xd1\xd4\x57\x49\xc6\x9e\xd6\x93\x9c\x96\xc9\x69\xa2\x3a\x12\x88\x0c\x36\xaf\x14\x06\x0a\xbe\x1c\x0c\x26\x89\xe9\x4c\xe1\x93\x1d\x2d\x6f\xcd\x89\x44\x17\x6d\x36\x54\x67\x8c\xd6\x36\xb5\x8c\x2c\xd4\x0c\xd1\x11\xb5\x5e\x2e\xa1\xad\x7a\xb8\x6c\x27\x6b\x2c\x9e\x46\x59\x02\x65\xe6\x84\x5e\xcb\xa1\x20\x91\x53\x51\x69\xd1\x73\x94\x92\x30\xd6\xc9\xfa\x08\x8b\x77\x97\xf8\x08\x2d\x19\x58\x8b\xed\x30\x56\x9c\x66\x8c\x7a\xdc\x58\xd2\x52\x33\xcb\xa6\x15\x5a\x1d\x13\x7a\x4e\x55\x78\x7d\xa8\x76\x53\x45\x66\x55\x1d\x26\x98\xee\xc8\xad\xb5\x69\x39\x13\x2f\xd2\x34\xd7\xca\x57\xd7\x39\xb9\xc6\x49\x18\xd6\x2f\x61\x85\x16\xd3\xf4\xdc\xb1\xba\xa9\xe4\x93\x1d\x35\x3f\x94\xb4\xc9\xbc\xdd\xa6\xfb\x25\x6b\xc5\x26\x0b\x4a\x7c\xba\x88\xd3\x82\xc0\x94\x1c\x22\x49\xe4\x3a\xdc\xb4\x9d\xf1\xc0\x90\x93\x17\xb8\xf9\xba\x33\x58\x56\x3d\xb5\x09\x46\x74\x94\x2a\xb6\xa6\xd5\xde\x90\x88\xeb\x04\xb0\x17\x15\xba\x50\x21\xb9\x42\xb3\xaa\x2f\x3a\xae\xa6\x65\x67\x60\xf4\xcb\x2e\x32\x45\x7d\x60\x2e\x98\x4a\xb1\xc4\xb0\xbd\xf5\xac\x3c\x2e\x8c\xbb\xdd\x59\x6d\xe8\xd8\xdd\x62\xda\xc9\xc9\xc2\xba\x6d\x71\x8b\x89\x96\x9c\x33\xc9\x59\x9c\xed\x66\x1a\x8d\xd6\xa4\x48\x95\xe9\xbe\xb7\x91\x88\x86\xa9\x64\x96\xfd\x8d\xea\xa8\x89\x45\x76\x92\x59\x89\x73\x73\xdd\x1f\x77\x3b\x54\xa3\xdf\x4a\xb5\x69\xa6\x99\x34\xf2\x71\xa3\x98\xf7\x12\x44\x19\x23\x9b\x59\x6b\x9a\xef\xf3\xb9\x71\x97\x2f\xe9\x5e\x2b\x17\x6f\xea\x6e\xae\xbb\x6c\x56\x93\xcd\x59\x79\xb0\xec\x2d\xcb\xa8\xa7\xf5\x47\x66\xb9\x43\xaf\xc7\xc2\x5a\xa8\xf4\x56\x78\xbc\x9b\xce\xd4\x84\x0d\xd0\xcd\x65\x7b\x96\x31\x8b\x4e\x47\x37\xca\x05\x6f\xda\x50\x9c\x3c\x6f\x1b\xeb\xb9\xda\xae\x64\xd1\x7c\x3f\xcd\xe7\x98\x61\xd9\x75\x30\x3a\x91\xae\x4e\xd9\xc1\x2a\x51\x57\x32\x2c\x35\xcf\xc9\x4c\x22\x2d\xd6\x0d\xc7\xc9\xf7\x65\xa6\x37\xc2\x89\x01\xde\xa2\x27\x2b\xdc\x9b\x2f\x1b\xa9\x3c\x35\xc9\x89\x46\x8b\x1e\x6c\x88\x75\xab\x3f\xa6\x0b\x8c\x3b\xaf\x77\x96\xa5\x78\x6e\x5a\xae\x78\x9d\xc9\xdc\xca\xa5\x87\xfd\x3e\x69\x32\xf3\x3a\x96\x20\xda\x8e\x87\x72\x03\x67\x0e\x7c\xb4\xcc\xac\x43\xd9\xad\x8c\xd0\x29\x66\x16\x1b\x65\xa8\xa4\xb9\xa9\xb0\xf2\xdc\xa4\x60\x76\x37\xf6\x78\x6d\x94\xac\xba\x9b\x74\xf9\xf6\xbc\x96\xcb\xf5\x4b\xf1\x62\x2a\x35\xcc\x74\xfa\x45\x59\xce\x08\x2a\x15\x4f\xf2\xf9\xac\x38\x1e\xe1\xcd\x7c\xae\xb7\xd1\x39\xd1\x22\x1a\x4a\x72\x5c\xf6\xea\xe5\x22\xd6\xea\x82\x01\x79\x33\x4e\xf7\x73\x5a\x0b\x8c\x74\x74\x56\x16\x38\x35\x51\x13\xc1\x40\x30\x37\x6b\x96\xbc\xc2\x4c\x91\x6d\xda\x66\xc3\x1e\x57\x5a\x6a\xce\x36\x59\x99\xea\x4f\x0a\x6c\x35\xd3\xd1\xc6\x7d\x9b\xaf\x24\xed\xb8\x96\xeb\xe4\x9b\x5d\x59\x6a\xb5\xfb\x99\xd1\xb2\x38\x56\x66\x86\x40\x93\xe6\x50\xa4\x5b\xad\xba\xde\xc2\xd1\xae\x40\xd8\x63\xde\x11\x5c\xbb\x93\x32\x53\x7c\x0b\x17\x50\xb2\xe7\x4a\xe8\x08\xab\x28\x33\xaa\x9d\x6d\xa4\xeb\x82\x55\x4c\xe7\xb8\x78\xb9\x57\x1b\x18\xf6\x8c\x49\x58\x35\x33\xc7\x2c\x5a\xe5\xcc\x26\x9b\xab\x76\x92\x78\xbe\x9e\xa7\x56\x78\x2b\x49\xa2\xa5\xb2\xc0\x55\xdd\xb1\x3b\x10\x28\x81\x54\x16\xde\x62\x3a\x28\xce\x92\xe8\x24\xa5\x76\x80\xd9\x29\x63\xd4\x04\x15\x31\xae\x3e\x19\xaf\x99\x75\x87\x37\xe4\x99\x8e\xad\x29\x16\xcb\xc8\x15\x59\x91\x8a\x84\x0e\xd4\xc0\xd5\xb3\x3d\x65\xe3\xb6\x8a\x99\x55\x23\x37\x9e\x3a\x7c\xa3\x9c\xab\xba\x6d\xbc\x3f\x63\xe7\x93\x09\x6e\xac\xa6\x6e\x6e\xe3\x91\x8a\xe4\xa8\xc2\xa4\xac\x4c\xf5\x22\x91\xcc\xe4\x67\xd6\x4a\x77\x32\x0a\x51\x59\x5b\xe5\x32\x35\x18\xd7\x53\x72\x5b\xa5\x47\x6a\xb2\x8f\x2d\xa8\x84\x6c\x0b\xa9\xb6\xec\xe8\x13\x2a\x59\x8e\x9b\xbd\x9c\x8e\x4d\x17\xf9\x72\xd1\xee\x24\x1a\x75\x75\x3d\xef\x8a\x16\x29\xa5\x59\x02\xeb\xf2\x0e\x51\xde\xac\x59\xa7\x58\x2a\x6c\xec\x4e\xab\x99\x68\x4d\x3a\xad\x01\x97\x28\x66\x2a\x18\x11\xa7\x6b\x5a\x07\x95\x52\xfa\x52\x9b\xda\xb5\x8e\x8b\xea\xec\xb2\x4d\x4c\x4c\x22\x55\xe2\x8a\x72\x9a\xaa\x77\xaa\x64\x3e\x97\x1d\x97\x87\xa5\x15\x96\x30\xbd\x45\xb5\x46\x2d\x5b\xe5\x0d\x70\x23\x78\xb2\x4c\x4a\xc3\xee\x00\x00\x58\x0e\x93\x2d\x31\x4b\xb8\x9c\x83\x76\x8a\xa8\x92\x66\xe9\x06\xe3\x65\x19\x31\xd9\xa3\x8d\x91\x90\xcd\xf7\x1b\x9c\x50\xb4\x12\x0d\x2f\x0b\xbc\x4b\x26\x69\x79\x12\x9f\x45\x73\x89\x1c\x63\x2c\x53\xfa\xa8\xd8\x40\x37\x98\x61\xa5\xb2\x79\x5d\xb5\xf3\x13\x51\x5b\xcf\xf8\xcd\x7c\xde\x10\x27\x46\xbf\x92\x25\xf9\x5e\x0b\xad\x95\x71\xb1\x83\x15\xf9\x71\xd1\x6b\xf5\x92\x89\xe2\x2c\x37\x9f\x97\xec\x1c\x29\x64\x46\xe4\x3a\x6f\x65\x99\xc5\x70\x68\x49\x1a\x5a\xd6\x70\xb1\xb5\xa6\xf9\xf5\x08\x2d\xbb\xb8\x90\xed\x4e\xb3\x73\xb1\xc2\x58\xc3\x78\x5f\x22\xba\x70\x5a\x90\xed\x0f\x47\xed\x5e\x3d\x99\x9f\x56\xab\xef\x97\x57\x68\x68\x05\x4c\x55\x72\xce\x1a\x69\xf2\x48\x16\xc9\xfb\x93\x9a\xa7\xed\x4c\x6d\xbb\xb8\x0b\x57\xa2\x0e\x0f\x7a\x84\xeb\x99\xa7\xc9\x70\xc5\xec\x60\x0e\xf5\x15\x0b\xa6\x95\xdb\xf9\x66\x70\x56\x2c\x98\xf6\xec\x4e\x0a\xe9\x1c\x1f\x9b\x2f\x1d\xde\x5c\xfb\x53\xa9\xe0\x31\x4a\xc2\xb3\x4f\x31\x4b\x91\x55\xff\x60\xd0\xfc\xe6\xb9\xa0\x25\x25\x63\x13\x34\x93\x4a\x16\x36\x6d\xdc\x1c\xa4\x69\xa6\x9e\x20\x6a\x7d\xbb\x5b\xcd\x2e\x47\x62\x6f\xb4\x31\x98\x8d\x9e\xb4\xd4\x49\xdd\x48\x4c\x85\x9e\x5b\x41\x29\x9a\xb1\x07\x45\xa2\x23\xa7\xe6\xf2\x46\xdf\xc3\xbe\x76\x3e\x08\xcc\x46\x7d\xdc\x3f\x6e\x10\xc2\x69\x73\x2b\xc6\x2a\xba\xc3\x09\x0a\x6d\x06\x13\x43\x7a\x4e\xaf\x30\x45\x66\x2c\xcc\xd0\x0d\x03\x4c\x59\xe7\x16\x46\xc4\x08\x78\xf0\xc9\x51\xb9\x6d\xe2\x7d\x0a\x87\xed\x38\x3f\xc0\xf3\x46\x65\xc9\xf5\x6b\xdd\x94\x54\xb3\xd7\xc9\xfa\xc8\x90\xec\x8e\xb4\x19\xcf\x33\xe3\x36\xc1\x2a\x95\x41\xb3\x4c\x93\xb5\xc2\xcc\x33\xb5\xee\x32\x61\x95\xa8\x14\x57\xad\xb4\x0a\x1b\x7c\x4c\xfc\x14\x0a\x3f\x71\x68\x6d\x7e\x7a\x66\xed\x36\x79\xb5\x79\x5f\x1d\x89\x6b\x0e\x37\x48\x63\x92\x23\xcc\x9e\xcc\xcc\x86\xd9\xa9\x5e\xad\xae\x53\x6d\xb3\x9b\x1a\x99\xf3\x6a\x91\x2e\x09\x98\x56\x2b\x6f\xaa\xab\x52\x01\x4c\x51\x56\xf8\xaa\xda\x44\x73\xc0\xd5\xec\x35\x7f\x56\x07\x9e\x9f\x59\xf3\xcf\x2c\x59\xac\x6e\xf2\xff\x20\x62\x19\x40\xd9\x3e\x21\x7a\x9f\xae\x24\x70\x91\xcd\x4c\x3f\x41\x8b\xcb\x3e\x39\xae\xbb\x1d\x53\x2a\xd5\x6b\xb4\x68\x4c\xd7\x95\x76\xce\x12\x48\xac\xb0\x72\x0a\xf5\x76\x6f\xbd\xcc\xbb\x71\x6b\xca\x9b\x19\x16\x2b\xae\x38\xa9\xd3\x6e\x50\xf9\xb2\xf4\x69\xba\xfe\x12\x8d\x22\x05\xde\xe5\x15\xdd\x50\x79\xcd\x46\xdc\x60\x8d\x06\xd1\x05\x64\xe4\x84\x4b\x33\x12\xaf\x18\x02\x5c\x72\x0e\x36\x8f\x11\x45\x17\x01\x54\xb8\x42\xf1\x38\x5b\x5c\x87\xff\x47\x3c\x96\x8a\x11\x78\x78\x80\xcf\xe1\x77\xac\x38\x67\x43\x06\xd8\xf5\x0d\x83\x49\x26\xc5\x13\x89\x72\xa3\xc2\x27\x07\xc5\xb6\x39\x90\x2b\x64\xd7\xf6\x92\x85\x49\x7c\xe6\x65\x26\x98\x98\x66\x97\x73\x8a\x18\xc7\x9b\x6c\xb1\xb9\x4a\xe6\xeb\x6d\x6b\xb3\xe2\x18\x6a\x2e\x06\x70\xef\xb2\x00\x89\x46\x3f\xdb\xbd\x97\xe8\xb8\xdf\xad\x94\x8d\xd2\xc0\x67\x19\x8e\x34\x2d\xd9\xef\x74\xca\x58\x8b\xe1\x67\xf9\x4a\x6a\x30\xae\xba\xc0\xf1\x57\x31\xb1\xc0\x38\x76\xcf\xb5\x8b\x7c\x51\xd9\xac\x56\x63\x7a\xd6\x42\xcb\xd8\xac\x5a\xe4\xaa\x98\x80\xae\x7f\x76\xb7\xf6\xfc\x65\xbe\x9f\xda\xbb\xd1\x60\xe9\xf0\x1f\x64\x0c\x8f\xa5\x76\xbc\x09\x53\x6f\x74\xf5\xa0\x97\x2b\xba\xad\x69\x4f\xd0\xbc\x39\xe7\xad\x31\x69\x38\x2a\xca\xe3\x6e\x5b\x61\x70\xae\xd3\x5a\xcb\x68\x1e\xc7\xda\xce\xac\x3d\xdd\x34\x3a\x6e\xa6\x93\x6e\xc6\xed\x59\x7c\xbe\xac\xf3\xed\x09\xba\x30\xfa\xe4\x9f\xda\xd5\xb7\x89\xba\xdf\xef\x7c\xab\x5f\x76\xa7\x59\x46\x1f\x62\x96\xd0\x4e\x70\x65\x97\x58\x52\xf9\x24\xa5\x9a\xad\x9a\x95\x21\x9d\x9c\xbe\xd6\xb0\x51\x37\xd9\xa7\xd0\x7a\x0e\x9b\x2c\x55\x59\x67\x8b\x85\xec\x42\xe4\xe8\x7c\xb9\xdd\x1c\xfc\x79\x66\xea\xfe\xd1\xda\xdb\x94\xe9\xf4\xa2\x5e\x9a\x8c\x6d\x67\xce\xd4\x26\x69\xaf\x3c\xab\xc4\xab\xe4\x86\x68\x4e\x96\xd4\x82\xc5\x7b\x4b\xa1\xa9\xad\x4b\xb9\x29\x6b\xe7\x72\x4d\x8c\x28\x27\xcd\xcc\xcc\x68\x94\xd3\xbc\xc5\xa7\x84\x01\xe7\x24\x3e\x43\xd9\x11\x69\x07\x87\x6d\x57\x51\x9b\x57\x0d\x85\xb6\xf9\xfd\x16\x54\x3e\x3c\x66\x34\xd8\xe6\x6c\x17\x67\x0f\x57\xd9\x83\xad\xd7\xdd\x06\x4b\x94\x55\x1c\x0b\xea\xc3\xee\x30\x29\x70\x23\x38\x00\xf4\x0d\x42\x8d\x6c\x53\xff\x19\x41\x50\xd0\x4e\xb8\x9b\xe5\x2f\xba\xbb\xb4\x72\xbe\x13\xf5\x55\xdf\xed\xce\x5d\x38\xf4\x74\xb4\x8d\x01\xb7\x39\xde\x8e\x76\x36\x23\xbf\x9e\x35\xe7\x46\x05\xdd\x7c\x7f\x7a\x86\x58\x97\x41\x9e\x01\x4f\xde\x73\xfc\xea\x05\x7c\x21\xfe\x16\x47\x55\xf3\xd3\xad\xa7\x10\x98\x8f\x7e\xd4\xd6\xdf\x9f\xfc\x82\x20\x39\xc4\xe7\x1b\x12\xa1\x59\x78\xde\x24\xf2\x16\xc0\x40\xde\xdf\xdf\x11\x1c\xf9\x0e\xd9\x7d\xb8\x09\xf2\x15\xd3\x0f\x37\x40\x0e\xb7\x2c\xf7\x24\x69\x47\xeb\xff\xd7\x8a\xf9\xfb\x46\x9f\xa2\xe1\x3e\xb2\xc7\x9b\x35\xfb\xc3\xb4\x61\x33\x30\x61\x0b\xd8\x87\x0a\x11\x60\x00\x8c\x37\x98\x12\xe4\xef\x92\x16\x7c\xb8\x9d\x17\x73\x1c\xc0\x6e\xe8\x8c\x6e\xe1\x1d\x11\x17\x6c\x02\xfd\x72\x69\xe7\xe9\xe2\x69\x47\x40\x48\xb0\x11\x70\xa1\x4b\x2f\xec\x8e\xfa\x7d\x06\x10\x81\x35\x6f\xec\x3a\x5f\x3f\x58\x19\x6e\x52\x06\x47\x59\xc3\x4d\xd3\xb3\xfd\xe8\x33\x78\x96\x19\xd5\x35\x65\xfd\xf4\xd1\x01\x70\x64\x00\xfa\xbc\xc6\xc9\x9e\xd9\x0d\xb2\xe1\xd9\xc6\x1f\x23\xdb\xaf\xf9\x19\xb2\x77\xc7\x28\xff\x20\xd9\x2d\x00\xe7\x0e\xc9\x27\x1b\x97\x5f\x25\x13\xc1\xb6\xd3\x95\x30\xe7\xf3\xb6\xaa\x13\xd8\x2a\xee\xc4\x4e\x9d\xa8\x10\x87\xec\x64\xf1\xa2\x21\x83\x19\xe1\x89\xba\xe0\x94\x10\x20\x5f\x63\xfd\x46\xde\xfc\x08\x93\xad\x64\x9b\xca\x01\x77\xff\xfa\x0d\xd9\xa6\x22\xdb\x9d\xc0\x23\x22\xcf\x6d\xe5\x85\xc3\xd6\x50\x81\x74\xed\x0d\x1a\x6b\x1e\x9e\x24\x7a\x7f\x82\xa7\x92\xfb\xbb\x92\x47\xf9\x0e\x8c\x36\xd2\xae\x17\x50\x01\x04\xb8\xbb\x29\x8b\xda\x0c\x14\x1a\x03\xc7\x24\xef\x1f\x74\x39\xb4\xab\xb2\x2a\x82\x2a\xb2\x10\x12\x25\xd1\xd6\x21\xb0\x37\x7f\xd0\xf3\x73\xf6\xe8\x76\xc0\xe4\xe3\xe9\x88\x5b\x10\xc8\x09\x4d\xa0\xae\x3f\xa7\xdd\xb1\x2a\x40\x8c\x55\x64\x76\xf1\xfe\xa4\x1b\xbc\xd6\x3f\x3e\xbc\xf3\xb4\x15\x80\x03\xb4\x78\x30\x08\xfc\xd0\xfe\x1c\x0f\x7f\x16\xad\x5c\xb6\x09\xf7\xe7\x0c\xbc\x42\x18\xfe\xfe\x1c\x91\x6b\x8e\x8a\x13\x39\x81\x0e\x13\x9d\x61\x99\x74\x98\x75\x6b\x51\xeb\x34\x37\x76\x5e\x36\xea\x1c\xc9\x93\xc9\xd6\x70\x34\x92\x67\xea\x92\xa4\x26\xf5\x25\xac\x93\x9f\xe4\xaa\xe3\x09\x84\x93\x2e\x82\x3f\xed\x55\xb6\x3c\xaa\x7b\x09\x06\x3c\x97\x18\x5c\x29\x76\x47\xbd\x84\xd6\x26\xa7\x83\x91\xc0\xf4\xa4\x7e\x85\x62\x8b\xae\x97\xab\x0e\x0a\x79\xaf\x44\x73\x55\x87\x1d\x4b\xb2\xa2\xd5\x74\x75\x9d\xb6\xb5\xe5\x60\x96\x58\x4e\x4b\x0d\xaf\x28\x14\x0d\xa6\xdb\x6a\xe7\x3b\xe4\xc4\x75\x37\x45\x71\xe3\x8d\x4b\x39\x2d\x9f\x4c\x69\x36\x95\xb4\xfa\xa4\xb1\xb1\x2c\x61\x3e\xee\x26\x37\x62\x31\xfb\xc7\x3e\x85\x84\x4b\x2a\x6c\x4a\x75\xd2\x8b\x9a\x30\x4e\x53\x42\x27\x85\xc5\x07\x5c\x0a\x23\x5c\x61\x22\x27\x4d\x75\xd8\x69\x25\x31\x2a\x69\x8f\x5b\x2e\x33\xd2\x9c\x64\x97\x16\x9c\xb2\x49\xae\xe4\x4d\x37\xc3\xe1\x4e\x59\x22\xf8\x44\x67\x9a\xc9\xb8\x4b\xb9\xac\x24\x17\x02\x43\x35\xf9\x05\x43\xb7\x97\x79\x6d\x18\xe7\x0a\x92\xbe\x94\x17\xd4\xa0\x9d\xa9\x4e\x08\x61\x61\x0f\x46\xa8\xbb\x41\xd1\x7c\xc3\x99\xd8\x99\x04\xa7\x75\x54\xae\x81\xa7\x52\xc3\x39\xcd\x68\x63\xb2\x36\xa9\x99\x4c\x93\x2c\x29\x6d\x7c\x40\x4f\x0c\x53\x60\xe6\xe6\xc4\xc6\xa6\x73\x85\x1c\x24\x52\xf1\x55\x5c\x18\xab\xb6\xd0\xa4\xdb\x33\x85\x24\x54\x0a\x27\x84\x5e\xdc\x8a\x53\xb3\xa9\xbd\x40\xcd\xa5\xb0\x48\x95\xc9\xe5\x66\x9e\xc3\xb5\x21\x29\x89\xa0\x13\x13\x89\x91\xa0\x8d\x26\x89\xd9\xd8\x9a\x2d\x57\x35\x1c\x43\xb9\x62\xbb\x91\xec\x24\x33\x85\x8c\xeb\xa6\x3c\x41\x5b\xd2\x39\xdc\x4b\x4e\x16\xf3\x4e\x5f\x58\x62\xe9\xb8\xe4\xc4\xad\xb1\x59\x21\x57\xe9\x4e\x9e\xdf\x98\x66\xb3\x29\x10\x46\x27\xcb\xb1\xa3\x42\xa6\x88\xe5\xa5\x16\xd1\xec\x6c\xba\x3c\xca\x91\xd2\x66\x82\xeb\xdd\xa4\x8a\xba\x85\x65\xaa\x9c\x96\x96\x6e\xba\x3f\xa9\xd8\x85\x2c\x3d\xe5\x8c\x44\x6b\xa4\xd1\xd8\xb0\x2b\xe2\x35\xa1\x83\xa6\xa7\x3d\x29\x91\x20\x4a\x6a\xc5\x4e\x58\x0d\xac\x6c\x76\x06\xe9\xb9\x81\xa1\xf5\x0c\xbe\xa4\x93\x95\xb9\x29\xc8\xe5\x71\xdc\x1e\x4c\x35\xb6\xbc\xc6\x86\xa9\x6e\xa5\x27\xa7\xdd\x66\x16\xa7\xea\x6d\x32\xaf\x72\x03\xc5\x9c\xe2\x23\x87\x1c\x6c\xbc\x7a\xa5\x5d\xd7\x98\xba\xd4\x1d\xc7\x8d\xfe\x70\x50\x50\x3a\x6b\x26\x85\x77\xc7\xcd\x0c\xd5\xa1\xb1\xb8\xdb\xcc\xaf\x30\x3a\x57\x2d\x24\x56\x2c\xa9\x16\x69\xb4\x99\xd3\x94\xee\x4a\xa6\x25\xd5\x51\x96\x18\xde\xe9\x52\x6c\x6a\xb9\x2a\xa4\x26\x44\x4f\xe4\xe2\xad\x3e\x95\xe9\xa6\xf2\x09\x2b\xc5\x14\x36\xae\x05\xea\xce\x70\x45\x9b\x8c\xa7\x39\x33\xed\x8d\xc7\xf1\x09\x20\xd1\xf4\x12\x53\x5b\xda\xac\xbc\x65\xa7\xa5\xf1\x95\x52\x23\x2e\x4f\xd5\x22\x9a\x4e\xa6\x87\x74\xaa\xd8\xee\xb4\x9b\xb5\x25\x2b\xcd\xd5\x5c\x17\x73\x12\xe8\xd2\xcd\x8e\xa7\x5c\x6d\xda\x52\xa4\x31\xe5\x68\x04\xef\x29\x6a\x8d\x34\x1a\x95\xbc\x65\x79\x49\xb7\x24\x49\xd3\x5c\x72\x5a\x43\x71\x6b\xd9\x70\x66\x23\x0c\xc3\xf1\x25\xeb\xb0\x1a\xd3\x4c\x8a\xc3\x56\x9a\xdb\x00\xb2\xe3\x2c\x57\xd3\x2b\x73\x8d\x22\xda\xa6\x4d\x61\x79\x36\xbe\xf6\x1a\x95\x76\xda\xae\x55\xf2\xde\x86\x55\xed\x65\x91\x01\x9c\x31\x35\xcc\x1c\x0c\xad\x09\x63\x76\x57\xab\x65\xd9\xa2\x50\x46\xb5\x66\x39\xbd\x33\x21\xb1\x7a\x5c\x73\x55\xc5\x8d\x17\xca\xc5\xca\x7c\x99\xe1\x00\x2f\xfa\xe3\x76\xb2\x83\x2d\x37\x66\x5f\x18\x4e\xa8\xc5\x24\xb1\xc8\x8e\xdb\x1c\x43\xce\xd7\xc2\x50\x68\x88\x0b\xd6\xc0\x0a\x5d\xaf\x9c\x1c\x6e\x44\x8d\x4d\x39\xce\x44\xe0\xd6\x46\x73\x9c\x22\xf3\x2b\xc5\x5e\xea\x54\x92\x5a\x96\xdd\x34\x85\xf6\x33\x6e\xb5\xd2\x16\xdc\x81\xd4\xed\xa4\x33\xde\x60\x4c\xb7\x9a\x9e\x5d\xa2\xca\xaa\x65\xd5\x2d\xc0\xc3\xc1\x7c\xc9\xa6\x0a\xad\x4e\x69\x20\xb5\x13\x6c\x39\x97\x64\x5c\x8c\x51\x73\xb3\x9e\x4e\xa1\x79\x6c\xdd\x51\xb1\x8e\x38\x64\x26\x13\x79\x84\xb9\xb5\xa1\x9b\xea\x27\x8a\x9a\x25\x8c\x45\xab\xd2\x32\x65\x80\xaa\x06\xf1\x12\x96\x2e\xcb\xa8\x09\x73\x3d\x4e\xaf\xd5\x41\x9e\x15\x46\x63\x71\x44\xb8\x6a\x1e\x33\xd4\x99\x25\xc4\x1b\x3c\xe9\x4c\xfa\x03\x0f\xc8\x54\x7f\x5c\xe0\x2a\xd2\xa0\x8d\x29\xd9\x16\x9f\xee\x4d\xcb\xfa\xac\xd1\xe9\x5a\x6c\x2a\xb5\x2a\x94\xc7\xb9\x15\xe8\xe7\x5a\x46\x13\x64\x1b\x6d\x92\x56\xa3\xc3\xa4\x8a\x0a\xdd\x92\xe6\xed\x02\xba\x61\xd4\x64\x73\xc1\xb6\x66\x52\x85\x01\x63\x17\x9a\x9b\xa6\x32\x8e\xc6\xd8\x1a\x3d\x17\xfa\xb2\xd2\x14\x00\xdb\x73\xa3\x64\x9a\xea\xb5\x56\xd3\x19\x5f\x1e\x75\x6a\x73\xaf\x9e\x48\xad\x46\x52\xbc\xbf\x64\x35\x6d\x3c\xe3\x26\x75\x79\xe3\xac\x33\xea\xac\x4b\x54\xcb\x9b\x82\xe3\x66\x97\x2b\x4c\xc9\xcf\x57\x53\x0a\xc3\xdd\x12\x63\x98\xa5\x65\x3a\x05\xe1\x10\x5e\x66\x33\x1e\x17\xc4\x8c\x3e\x45\xeb\x82\x96\x9e\xb8\x62\x6f\x9a\x36\x56\xc6\x1a\x1b\xb0\x9b\x21\xc0\x0d\xfc\x9b\xcb\x26\xa4\x89\xe3\xf3\xb9\x99\xba\x99\xb5\xcd\xcc\x8a\xc1\x9b\xd3\x24\xe5\x02\x5a\x27\x5c\xcb\x9b\x5b\xb3\x79\x43\x5a\x34\xfa\xf5\x54\x61\xe0\xd1\xc6\xcc\xcd\xe8\x93\x2c\x61\xa7\x16\x22\xd3\x6c\xa7\xa8\x02\x8a\x36\xbd\x09\xc9\x75\x6b\x76\x65\x45\xcd\x12\x85\x59\x8b\xd0\xfa\x8c\x9b\xcf\x90\x05\x8c\x22\xf9\x65\xbc\x23\xf7\x3a\xb9\x25\x51\xa1\x67\x0b\x8b\xea\xa8\x39\x9b\x21\x67\xfd\xd9\x0c\x27\xd4\x22\x87\x36\xf0\xc6\x84\x55\x85\x24\x39\x21\xe2\x99\x01\x36\x29\x7a\x85\x11\x39\x19\xeb\x82\x97\x2c\x49\x6a\x02\xe5\x2b\x55\xc6\x32\xdb\x58\x4a\x1f\x49\xdd\xe4\xba\xac\x31\xe5\xa6\xa1\x11\x58\xb3\x40\xbb\x52\xa5\x4f\x0c\xa8\x0e\xee\xa5\x4c\xaf\x5d\x56\x9d\xf2\xa0\xd2\x51\x14\x57\xa4\x6a\x71\x8e\x01\x36\x64\x46\x00\xe7\xa3\x59\xc2\x34\xa9\x8b\x1a\x14\xb3\x61\xc9\x3c\x26\x6c\x72\x05\x34\x15\x9f\x50\x0e\x49\x2f\x2b\x98\x3b\xca\x27\x14\x20\x16\x1b\xaa\xb3\x99\xf4\x8b\x15\xd4\x5d\xa2\x6a\xba\x27\xa0\x4a\x57\x75\x33\x4d\x82\x6d\x19\x12\x90\xab\x26\x41\x26\xb8\x16\xc3\xc4\x53\xb2\xa6\x67\x52\x89\xb2\x2d\x96\xd1\x3e\x6a\x2c\x8c\xbc\x30\xa7\x36\x92\x3c\x1e\x62\x12\xed\xd5\x3b\xb5\x46\x2e\x1d\x77\xb4\x84\x81\xb7\xb5\x01\x1e\xe7\xe6\xf3\xa4\xee\x94\xa8\x94\xc6\xa6\x05\x8a\x4d\xf7\x38\x36\xde\x5e\x68\xb6\xb6\xd9\x24\x16\xe9\x91\x9b\x19\xa8\x7c\x7a\x90\x6d\x6b\x95\x11\x9d\xf3\x3c\x01\xc3\x56\x84\x66\x30\xc9\x36\xd6\x2b\xcd\xdc\x9e\x39\x45\x1d\x1c\x98\xa3\x46\xdf\x18\x6c\x0a\x92\x54\xae\x64\x7a\x7d\x74\xa2\x02\xcb\x54\x48\x4c\x38\x52\xe0\xd3\xe8\xc4\x11\x7a\x78\xfe\x0f\x8e\x49\x54\x0b\x4b\x94\x48\x92\x92\x37\x5c\x79\x35\x1e\x53\xe7\xeb\xe4\xf7\x3c\x8c\xe0\xb7\xa6\x1f\x39\x1d\x3b\x1f\xe2\xaa\xef\xe5\x83\x83\xc7\x77\x0f\xbd\x20\x29\x79\x94\xed\xbb\x79\x4f\x87\x7e\x11\xfc\x33\xf0\x53\x3f\xb6\x9e\xde\x2e\x09\xf9\xfe\x15\x93\x92\x0f\x40\x83\xee\xcc\xc7\x57\x5e\xfd\x68\xe9\x88\x9f\xf8\x15\x03\x3f\x4e\x2a\x1b\xc7\x75\x4f\x7d\xf8\xc0\xe3\xde\x4e\xe7\x22\x41\xa4\x8e\xff\x37\x6a\xc8\x8a\x12\x78\xac\x7e\x5c\x47\xf0\xe8\x99\xb4\x81\xc0\xb9\x82\x5f\x26\x0f\xab\x95\x74\xb3\x6f\xd3\xb6\x63\x3d\xbf\xec\xa9\xb1\xfc\x14\x48\x8a\xef\xb7\x83\x09\x49\x38\xef\xb3\x69\x71\x3b\xed\x8b\x81\x67\x6b\x37\x17\x01\x3f\x62\xfe\x19\xd6\xff\xfa\x2f\x44\x73\x14\xe5\xec\xc8\xd5\x96\x90\x1b\x38\x3e\x9d\x50\x12\x85\x98\x42\xc0\xd0\xcb\xf7\x91\xf3\x7f\xc0\x70\xb9\xef\x27\xf3\x07\xe3\xb1\x9e\x3e\x3f\x52\x47\xef\xcf\xd8\x6e\x11\xb4\x35\x04\x9e\x4e\x07\x1e\xb5\x1f\xbf\x19\x9e\x52\xf7\xd3\x2c\x15\xf1\xe1\x04\x87\x20\x4f\x7d\xd8\x02\x0f\xfc\x76\xc5\x0a\x1c\xd8\x8f\x91\xcc\x7b\x48\x98\x04\xb1\x3d\x98\xd6\x9d\x36\x61\xf1\xc0\xe7\xe7\x2e\x35\x82\x08\x8a\x4e\xdb\x41\x04\xc0\x8e\xd7\x7b\x2f\xda\x0f\xf9\xd6\x74\x90\xca\x9b\xa6\x7f\xd0\xfb\xf4\x70\x9d\x6c\xc9\xb6\x7f\x6c\xf4\x80\x61\x47\xa7\x2d\x7f\x78\x7e\x05\xb1\xa8\x04\xa1\x46\x03\x78\x7c\xff\x74\x9e\x15\x84\x1f\x6d\x0f\x2c\x06\xb1\x48\xf0\x6f\xd4\xb2\x01\x68\x9e\x0b\x7f\x49\x70\x66\xb3\xcd\x51\x91\xf3\x08\xa6\xfd\xb4\xcc\x86\xe9\x3b\x88\xf0\x07\xe0\x11\x64\xcc\x41\x7f\xda\xe6\x91\x7e\xd8\x12\x62\xb1\xba\x11\x1c\x70\x7c\xfa\x08\xf0\xfd\x8a\xd9\xd2\xad\x52\x23\x18\x28\x75\x5c\x08\xfc\x32\xf7\xec\xb3\xf7\xf7\x3d\xc0\xda\xfb\xe3\xff\x21\x0a\x5b\x6d\x09\xe7\x8d\x40\x61\x42\x8a\xf6\x12\xce\x86\xba\x17\x60\xf4\x1c\xe4\xbf\x1c\x2b\xb7\xbd\x23\x36\x8c\xe0\x82\x37\x23\xf8\x7a\x10\xfc\x8e\xc1\xdf\x50\x15\x6c\xee\x76\x3d\x3f\xf2\xeb\xb0\x62\x10\x0a\x76\x52\xf3\x84\xc6\x83\xa0\x06\xcc\xef\x88\x1f\x17\x93\x5e\x18\xfd\x67\xdd\x98\x8b\x1f\x85\x09\x5a\x07\x9d\x7e\x55\x8c\x42\x61\xd9\xd5\x09\xc5\x25\x34\xd4\xbb\x64\xe4\xef\x7f\x47\x76\x3f\x62\x0a\xaf\x89\x60\xc2\x7a\xd8\x5d\xf7\xa5\xea\x4c\xae\xce\x65\x26\xb0\xa1\xa7\x92\x75\x5e\x6e\xd8\x6b\xdc\x2f\xd4\xd0\x59\x3f\x38\xe2\x4c\x50\xb1\x43\x34\x4e\xe4\xf0\x5c\x12\x61\x4a\xc8\xf0\xdd\xf2\xe0\x96\x13\x87\xab\x83\xe7\x5c\x3f\x94\xe4\xbd\x8d\x0b\xc2\x0b\x9e\x83\x8a\x4f\x17\x3b\x0e\xc6\x77\x9e\x1d\x23\x07\x02\xf9\x71\xb4\x36\x74\x36\x06\x04\x8f\xb2\x26\xe8\xbe\x94\x6e\xa1\x9d\x8d\x43\x27\x92\x7e\x22\xed\x07\x23\xc9\x11\x14\x7f\x15\xe6\x07\xeb\x2a\x61\x57\x5c\x02\x70\xdc\x1d\x7b\x8e\x41\xf1\xdb\x86\x51\xf8\x2b\xaa\xc1\x1a\xf1\xc5\xd6\x75\x05\x52\xf6\xfe\x44\x5e\x0a\x2a\xb9\x10\xce\x19\x2e\xa9\x86\x89\x7b\x31\x8f\x6d\x2d\xcc\x76\x75\xf5\xa8\xda\x59\xd3\x77\x69\x01\xbf\x43\xd1\x39\x12\xb8\x43\x01\x3b\x30\x0b\x7f\x68\x20\x09\x62\x1c\xe0\x18\x75\xc3\x3c\x98\xba\x87\x5c\x8c\x3e\x7d\xfa\xb8\x16\xf7\x13\x4d\x1c\xab\xf0\xe1\x22\xf6\xe9\x52\xf5\xe5\x35\xe9\xd3\x75\xc9\x13\xf8\xd4\x05\xf8\x7b\xb3\x13\x36\xb2\x4b\x08\xc7\xed\x03\x65\x0b\xdb\xdc\xa5\x9c\x43\xbb\xdd\xf7\xc1\x1a\xdd\xa3\xfd\x7e\x10\xd2\xa4\xc8\x96\x1d\x75\x34\xff\x40\x09\xb7\xf5\x9c\x6d\xde\x3a\x72\x72\x83\x94\x13\x29\x51\xe4\xad\x25\x81\xd9\x3b\x57\x30\xac\xbd\x73\xdf\x7c\x6d\x82\xde\x1b\xcc\x08\xdd\xb7\xaf\x96\x4a\x2b\x0a\x54\xae\x20\x31\x74\xe3\x82\xd4\xd3\x00\x91\x1b\xe1\x21\x7f\xc8\x61\xb1\x72\xeb\x7d\xd8\xcf\x15\x91\xdb\xc9\xb7\x14\xdf\xc5\xe8\x04\x77\x7a\x44\x13\x81\xb7\x1a\x84\x88\x1e\x47\x3a\x23\x06\x13\x05\x4a\xec\x47\xe8\xc0\x08\x89\xc3\xe8\x22\x29\x7e\xe4\x91\x06\x4c\x0e\xb7\xc4\xaa\xfe\xbe\x4b\x14\x21\x90\xaf\x3e\x2f\xf7\xf5\xf2\x41\x81\xdd\x98\xb5\xe5\xfc\x51\x45\x19\x1a\xd5\xa0\xdc\x40\xef\x4b\xbb\x5b\x91\x8e\x24\x3e\xd8\x72\x0b\xc5\x67\xcb\x8a\xf3\x86\x7e\x3b\x45\xe9\xf7\x60\xc3\xe6\x50\x5f\xac\x4f\x54\xf6\xcb\x1f\x9e\x70\x3a\xdd\x0f\x7a\x1c\x85\x23\x5f\xff\x90\xaa\xcb\x7e\x7f\x18\x0d\xf9\x8f\x70\xe0\x3a\xe6\x10\x82\xbe\x23\x44\x12\xee\xe4\x85\x71\xa7\x67\x05\x3e\xde\xef\x75\xc5\x89\x23\x7f\x38\x47\x50\x44\xff\xcb\xbf\xf6\x05\x39\x8d\xb1\x05\x8e\x02\x6c\xa0\x09\x52\xf6\x81\x8b\x3f\x47\xae\xfd\x98\xb3\x3f\x55\xa4\xc3\xa8\xb6\xcf\x48\xf3\x16\xaf\x3f\x49\x86\xb7\xe0\x2f\x88\xcd\x65\xb9\xbd\x51\xe1\xae\xb4\xde\x6e\xec\x7f\x44\x42\xcf\xd8\xfb\xef\x24\x97\xfb\x71\xfd\xcf\x13\xcb\x2b\xd2\x08\x79\x73\x26\x8a\xa7\x32\xb8\x2f\xb4\xdd\x21\x3f\x97\xbe\x03\x97\xe3\x4c\xf6\x7e\x3b\x6a\xe5\x82\xad\xbc\x5c\xee\x7c\x5b\xfc\x32\x24\xb8\xc5\xba\x6f\xfd\x21\x29\x3a\x20\xe2\x82\x08\x1d\xe6\x6e\xe5\xe7\xdf\x52\x70\xfc\x70\xd2\x3b\xfe\xe0\xc9\xf5\x1f\x17\xf7\x6e\x83\xb0\xd4\x3d\x48\xc8\xd2\x2b\xab\x43\x17\xaf\x6d\x38\xa8\xda\x08\x72\xda\x61\xc6\xe1\xf2\x1e\xf9\x11\x66\x22\x7e\xc9\x58\x2c\x06\x84\x92\xbc\xec\x35\x6e\xaf\x81\xb8\x7a\xa8\x63\x5b\x20\x0a\x6f\x0e\x60\xc4\x60\x4a\x74\xc0\x94\x6d\xfd\x70\xa3\x7f\x5b\x1c\x94\x0e\x77\xe9\xfd\x99\xbd\xa6\x7b\xef\x4f\xf8\x61\x8a\x0a\x8f\xfe\x1c\xa7\xd0\xab\xf7\xa7\x78\x12\xc7\x4f\xb8\x72\x2a\x62\x3f\xe4\x7a\xcd\x69\x97\x0e\x52\x0f\xef\xea\x73\x34\xd6\x9f\x4a\x19\xf0\x72\xcc\x3e\x40\x1b\xfc\x78\xb6\x82\xef\x97\x93\xdb\x19\x14\xde\xf6\x8f\x2f\x20\xef\x27\x19\xbe\x65\x0e\xce\xda\xbd\x21\x61\xe5\x58\x98\xf0\x7a\x21\xba\x95\xb6\xad\x7d\x39\xff\xe7\x79\x29\x5f\x15\xde\x90\xdf\x7e\xbf\x9c\x75\xee\x07\xc0\xb2\x47\x45\xbf\x9f\xdc\x1f\x64\x22\xcf\x90\x02\x58\x7b\x08\x66\x9f\xc0\xc0\x6c\x51\xf0\xdb\x7a\xb9\x40\x14\xa4\x36\xc8\x8d\x19\x8e\x25\x3d\x1f\x55\xf8\x2d\x84\xf4\xfb\xc9\x95\x39\x57\xda\x85\x06\xe4\xb4\xd1\x73\x2a\x2e\x61\x01\x6b\x6f\x0f\x6b\x5d\x62\x3d\xfc\x40\xe8\x6f\xfe\xdf\xd7\x8b\xf9\x3b\x76\x9e\xe5\x7e\x3f\x4b\x39\x63\x95\x2e\xdc\xc1\xfa\x37\xd8\xf0\xef\x2f\x57\x70\x0b\x71\x7f\x80\x91\x0f\x20\xb7\xeb\x92\x0b\x9e\xa0\x0f\x3a\x6c\xed\x66\xa7\xdc\x02\x62\xe9\xa6\xfd\xfc\x4c\xbf\x22\xcc\x0b\xf2\xfe\x71\x81\x24\x93\xb7\x1d\x53\x43\xb6\x82\x11\x58\x6b\x30\x48\x30\x47\x09\x27\xcd\x9f\xa0\x13\xc2\x80\x78\x5c\xbc\xa2\x65\xe4\xf8\x07\xe3\x0d\x5d\x03\x83\xed\x73\xa4\x73\x69\x9a\x14\x79\x3d\xbd\x57\x2f\x34\xcd\x6f\x48\xe4\xd7\x9b\x13\xab\xc8\xb1\x8c\xc0\x43\x92\xaa\x1c\xea\x50\xe4\xaf\xdf\x00\xe0\xc8\xf7\xc8\x89\xe2\x41\x54\x9f\x5f\xae\xb3\xe3\x66\xd7\x87\x43\xdc\x1b\x18\xfe\xee\x74\xf1\xf7\xe3\x56\x81\x31\x35\x00\x56\xdf\x1e\xb6\x01\x59\xd3\xa4\xd7\x57\x7a\x1e\x76\xc2\x1d\x0e\xef\x1c\xf6\x47\x98\x7b\xe6\xdd\xff\x2f\xe1\xeb\x65\x36\xbe\x9e\xdc\x10\xaa\x1a\xf0\xae\x83\xab\x30\x42\xf6\x3c\x5f\x33\x0a\x60\x88\x74\x14\x1b\xda\xb3\xef\x17\xf3\x8f\x8c\x10\xb4\x40\xb6\x24\x5b\xd7\x2d\xf5\xee\xcc\xae\x80\x3c\x07\x6b\x30\xa0\x75\x7f\x2d\xde\x5f\x4c\x85\x6d\xdd\xaa\xb6\xc7\xe8\xb7\xa3\xda\xbf\x1f\x1a\x2d\xf8\x78\xa2\xc7\x47\x1c\x42\xfc\xd3\x50\x3f\xd0\xc8\x55\xab\x7e\x44\x19\xe0\xf5\x3f\x63\x8e\x26\x2f\x1d\xbe\xca\x3d\x47\x60\xed\xed\x19\xde\x7f\x46\x5e\x5e\xef\x02\xd8\x0e\x01\xf0\xfb\xf7\x9b\xa5\xbf\xff\xf2\xb9\x9c\xef\x57\x7a\xd8\x17\xe0\x7f\x06\x3b\x1b\xd6\x73\xd8\x0b\x5f\xee\x49\xea\x43\xfa\xda\x3f\x9e\xc8\xdc\x54\xd7\x2b\x93\x9e\xff\x3e\x6d\x3d\xf0\xf2\xff\x5b\x54\xf5\x21\x0e\x96\xb7\x1e\xfd\x4d\xde\x9d\xf9\xfd\x3f\xc2\xb5\x87\x48\x78\xfd\x23\x36\xfe\x71\xe3\xa4\xd2\x0b\xbe\x00\x7a\xd1\xe2\x6f\x18\x27\x68\x77\x34\x9d\xe3\x2d\xdf\x3e\x7d\xb9\x5a\x86\xe7\x44\xbf\xcc\x6f\xbf\x7f\xf9\xe5\x67\x5a\x31\x7f\xee\xc9\x01\xc0\xff\x82\x4f\xff\xfc\xeb\xb7\xdd\x49\xed\xef\xff\xba\x6e\x80\x7c\x8c\x83\x79\x2b\x77\xdf\xa6\x40\x7b\x12\x94\xbd\x6d\x3a\xfc\xfb\x9f\xde\x76\x67\x67\x6f\x17\x86\x77\xc3\x19\x40\x6e\x0c\x5f\xae\x6e\x16\xf5\xad\x02\x50\x87\xeb\xb6\xe6\x0a\x4f\x8f\xcc\x3c\x3c\xf0\x70\xcf\xb0\xef\x3a\x01\x9e\x94\x00\x7d\xf0\x70\xc5\x6d\x37\x83\xb2\x41\x6f\x80\x07\xd0\x19\xf0\xc4\x83\x44\x5b\xd2\xad\xbe\x38\x44\xf4\x2f\xcf\x01\x00\x30\x12\xf9\x5d\xf4\xf2\x48\xbb\xfb\x0e\xf5\x2b\x3f\x36\x46\x1c\xf6\xad\x5f\xed\xf5\xe1\x2a\x61\x37\x6f\x4f\x73\x3c\x5e\x71\xdb\xe5\xa0\x66\xe4\xf1\x5a\xdb\xde\x7f\xac\xc6\xf7\xfb\x8c\x7e\x68\xf4\xbd\xc4\xd8\x70\xe3\x1d\x7d\x47\xc8\x07\x5a\xb9\x5b\xc2\x37\x09\x81\xbf\xf0\x18\x2e\x82\x09\x2f\x6b\x0c\x35\x11\xb1\xf5\xb0\xe7\xee\xa3\xf2\xf2\xe5\x87\x07\xf1\xfb\x7a\x45\x73\x9c\xf9\xb8\x62\xc1\xd2\x3b\xcd\x7a\xa8\xea\x56\xb5\x60\xe1\x40\xb7\xe0\x13\x50\x2e\xf8\xf5\xb8\x62\x85\xd5\x7f\x50\xb3\x82\xda\x9f\x57\xad\xa0\xde\xa7\x75\x0b\x56\xfb\xbc\x5e\xc1\x5a\x3f\xa0\x58\xff\x83\x7a\x15\xb2\xf5\x40\xb1\xfe\x3d\xf4\x2a\xc0\xeb\x4f\x55\xac\x4f\xa8\xdb\x4e\x79\xb6\x4b\x3b\x87\xde\xc1\x63\x0b\x43\x87\xba\x70\xbc\xc8\x12\x2e\x4a\x7c\x7d\x47\x88\x7b\x2a\x01\xd7\x6b\x65\xcd\xe1\xbf\xfc\x88\xbd\xd8\x6e\xbb\xf8\x1a\xbc\x9d\x8c\xfc\xf5\xdb\x16\x99\xc7\x3c\x96\x1d\x90\xc7\x9c\x96\x5d\xf1\x87\xfc\x96\x48\xc8\xca\xc8\x63\x8e\xcb\x3e\x3e\xf2\x41\xf7\x05\x41\xaf\xf0\xfe\x3f\x10\xf2\xe5\x87\x7c\x1b\x5f\x30\xb6\xfe\xe2\x11\xe8\x7b\x5d\xf9\x29\x1d\x09\xf4\xe3\x82\x83\x19\x28\xcb\x8e\xcb\xbf\xfc\xa8\xae\x5c\xd5\x86\x5b\xb3\xc5\xdf\x34\xde\x43\x60\x30\x2e\xf4\xd1\xfb\xbc\xfd\xbc\x9b\x3e\x86\x06\xfe\x15\x39\x2d\xe1\x53\xfd\xf2\xfb\xe7\x66\x55\xaa\xee\x68\xfe\x0c\x61\xb7\x02\x7e\x71\x32\xe0\x2b\xe4\x5f\x61\xd8\xdd\x40\x66\x17\xcf\xcf\x57\x96\x04\xfd\xf8\xb2\xe7\xc8\xaf\xc1\x59\xd6\xc8\x4b\x4c\x92\x39\xfe\xf9\x0a\x6f\x60\xc1\x0b\x1b\x18\xa0\x16\xdc\xc8\xb9\x56\x6b\xbb\xf8\x0e\xe7\x2d\x40\x4d\x7c\xc4\x0e\xe7\x32\xb7\x6b\xdd\x54\x2c\x9f\xb3\x6f\x3b\xe8\xbf\xe1\xbf\x5f\x17\x7d\x9f\xd9\x07\x65\x89\xdf\x3f\xb1\x22\xe0\x4f\x84\xb6\xb7\x6e\xbf\xef\x19\xb1\xdd\x42\x89\xbc\x5c\x51\x0b\x7f\x3e\x16\x44\x6d\x83\x7a\x5b\x01\x68\x05\x29\xcf\x3b\x38\x91\x17\x88\xbb\x8f\xdc\xeb\x0d\x7a\x01\xb3\x75\xc7\x7e\xbb\x67\x6a\x54\x80\xaa\xcb\x73\x8d\xb0\xb4\x1f\xf0\x7c\x9d\x31\xdf\x5f\xef\xf1\xf7\x76\x73\x96\x44\x1b\x70\xc6\xcd\xe9\x76\xe4\x87\x5a\x09\x7b\xe6\x9e\xb1\xf7\x2f\xed\xfe\xb6\x7d\x2b\x11\xf4\xdb\xf5\xc8\x2d\xb0\x3e\x6e\x2a\x90\x6b\xe9\x8f\xb0\xc0\x90\xd6\x96\xcc\xde\x45\x8f\xd7\xfc\x7d\xd1\xbb\x2d\x85\x66\x92\xe5\xb3\xb6\x42\x5b\xf1\x1c\x90\x45\xee\xed\x01\x1f\xc5\x32\x4c\xa0\x70\x0d\xdf\x40\xbf\x21\x71\x12\x7f\x7d\xb0\x0a\x7c\x83\x04\xbc\xba\xe7\x0d\xc1\x63\x04\x75\xdb\x24\xde\x86\xa9\xd2\xab\x11\xaf\xe8\x2c\x18\x61\xc0\xe8\x91\x48\xdd\xe1\xbc\xae\xb8\xf0\x0d\x06\x91\x53\x6a\xef\x8c\x4e\xb6\xac\xf2\xc0\x7c\xc3\xfb\xfd\x63\x64\xf2\x4e\x1b\x36\xcd\xc8\x8a\xbc\x09\x5f\x3f\x75\x9f\x8b\xbb\x5e\x82\x61\xc7\xf7\x39\x08\x57\x87\x7c\xd8\x16\xbc\xa7\x1f\x7f\x80\xe7\x8e\x01\x54\x98\xaf\x86\x77\x0d\xc0\x5a\x3f\xca\xf1\x1b\x59\xfe\x88\x7f\x57\x22\x83\x95\x8c\x47\xb8\x12\xaa\x56\xe4\xd7\x38\x45\xa7\x13\xc9\xc8\x1f\x11\x12\x7f\x32\xfd\xa9\x46\x71\x3c\xcd\x08\xc2\x1f\x6b\xd4\x9f\x69\x7c\xaa\x55\x22\x4d\xc7\x19\xea\x8f\xb5\x7a\xe0\x71\x7d\xaa\x6d\x41\x60\x09\x3c\x1d\xf9\xb9\xae\xfa\xb5\x01\x28\x1c\x7c\x62\xba\xf6\x1c\x39\xd2\x97\xdd\xd0\xf5\x0a\x7d\x36\x93\x56\xad\x1b\x2e\xc2\x6e\x0c\xe4\x4d\x78\x88\x06\xba\x78\xef\xdb\x6a\xb1\xbd\x9a\x20\x18\x12\xa6\xd9\xba\x4d\x2b\x2f\xc0\x95\x24\x70\xfc\xba\xa3\xb5\x1d\x52\x63\xb4\x6d\x9b\xcf\x91\xa3\x33\x07\x00\xaf\x33\xf8\x2f\xf0\x05\x82\xcf\x11\xff\x72\x32\x90\xff\x2f\xe0\xfd\xed\x10\xfa\xfe\xb7\x7f\x5d\x71\x40\x1e\xe0\x0d\xcb\x9f\x70\xa7\xba\x6b\xb3\xa0\x6b\x70\xa1\xf9\xf9\x0e\x77\xee\x90\x02\xcd\xc7\x09\xf6\x11\xf8\xb2\x88\xc8\x0d\x37\xf4\xba\xbb\x75\xcb\x49\xbb\x4b\xed\x96\x4e\xfe\xd9\x47\xea\xc2\xae\xc6\xe9\x0e\xf4\xe9\xc2\xb9\x65\x9b\xfa\xfa\xcf\x73\x41\xaf\x39\x93\xdf\xaf\xee\x8c\xdf\xda\x2d\x68\xe9\x76\x09\xbe\xf6\xe3\xce\x86\xc1\xd3\x57\x89\xf8\x68\xeb\xba\x61\xc5\x10\xd0\xe5\x11\x1b\x59\x80\x9e\x43\x3c\xe0\x6c\xf0\x80\x12\x1a\x5e\xcf\x0e\xcf\xe2\x10\x1f\x4f\x0f\x35\x7b\x74\x6a\xef\xee\x9e\xec\xe9\x35\x38\x3f\x75\xaf\x02\x4e\x3d\xfb\x36\x74\x06\x5e\x3f\xb1\x8f\xf1\xd9\x6d\xd3\xed\x85\x30\x37\xf6\x4d\xc3\x5d\x35\x56\x72\xb4\xc5\xf3\x7e\x3f\xe1\x15\xcc\x37\x7f\xce\xde\xda\xee\xd4\xfd\x4d\x86\x9f\xde\xe5\xf1\xd3\x37\x86\xde\x90\x36\x33\xe7\x59\xfb\xe6\x34\x8e\xb7\x25\x9d\xbb\x08\xe2\x62\x38\xe5\x8d\xfd\x9e\x20\xae\x25\x0f\x7c\x75\xe4\x3d\x38\x0e\x05\x1c\x90\x67\xec\xff\x3e\xff\x27\x87\xbe\xfc\xa7\x85\xc5\xf8\x15\xcf\xee\xf9\x1d\xc6\xc1\xc0\x19\xc7\x15\x13\x02\x57\x65\x0e\x80\x7e\x20\x89\x4c\xe6\xd6\x0c\x3e\xec\xd9\x30\xce\x92\xa3\x35\x11\xe8\xf1\x15\xeb\x14\x2c\xca\x9d\xb5\x40\x7e\xa6\x85\xf0\x4d\x0b\x9f\x6c\x22\xfe\x99\x26\xe0\x51\xb9\x4f\xc2\x27\x3e\x03\xdf\x72\x58\x16\x0e\xbe\x37\x9b\x78\x18\xd8\x36\xe2\xf3\x1a\xb8\x5f\x1e\x70\x6d\x8e\x2f\x7b\x79\xe6\x5d\xa0\x51\x2f\x57\xcd\xb5\x9f\x1d\x0b\x02\x44\x83\x71\xed\x1b\xf0\xfd\xb6\xaf\xc4\x8c\xc0\xf5\x28\xf8\x96\xe9\xe7\xf8\x4b\xe4\xe2\x52\xcb\x05\x04\x4e\xef\x9b\xf9\x59\x28\x10\x8f\xa3\x70\xe1\x42\x9b\xdb\x58\xf8\xab\xa0\xbb\xb7\xc6\xbd\x9f\x63\xa5\xe8\x16\x18\x2e\x9f\x23\xd7\x5f\x82\x1a\xb9\xba\xd8\x72\x9b\xc0\x68\x70\x07\x1b\xa0\xf3\x39\x2c\x09\x9b\x98\x20\xd1\x3d\x42\x31\x5d\x10\x2c\xde\x7e\x7e\x89\xc1\x77\x82\xbd\x00\xef\x6c\x9f\xe5\x7b\x21\xcf\x2f\xa1\x8b\x86\xa0\x48\xe4\x6f\x7e\x2c\xf7\x21\xb0\xe9\x65\x60\xb6\x6e\x1c\xc3\x0a\x2e\x91\x3d\x06\xf6\x30\xcf\x2f\xdc\xd7\x73\x9b\xe7\x21\x7e\xa6\xff\x5d\xe0\x05\xda\x51\xec\x5b\x6b\x4f\x2a\x04\xb9\xb5\xf5\x7e\x1f\x3d\x9d\xbe\xdd\xeb\xe9\x4a\xf5\xa3\xaa\x31\x41\xd6\x38\xd0\x93\x7e\x62\x10\x79\x0f\x9c\x15\xb8\xe5\x78\x60\x5b\x1d\x53\xf9\x0c\xac\x03\x81\x80\x01\xda\x00\x5e\xe0\x3e\xc2\xd0\xec\xc8\x2b\x72\x60\xb3\x8f\xae\x48\xfa\x4c\x13\x27\x82\xb7\x6b\xc2\x32\xd9\x5b\x2d\x6c\xfd\x58\xc5\x3e\x2a\xf5\x28\x7d\xfe\x2f\xd0\x08\x70\xe5\x22\x8f\xcb\xc1\x61\xcc\xfb\x9f\x2f\x04\xdc\x61\x84\xfd\x8d\xba\xa6\x7f\x52\x62\xeb\x6a\xc8\xc0\xa4\x44\x1e\x09\xa8\xbb\x1d\x4b\x77\x4d\xed\xe1\x12\x21\x68\xea\xc6\x32\xb8\x7f\x5f\xd5\x9d\xf9\x66\xd8\xd6\xdb\x41\xcf\x85\x49\x3f\xb2\xe0\x60\xf2\x9a\xff\x36\x4a\xc0\x88\x58\xf0\x7c\xbd\x2c\x1c\x12\x65\xb6\xe7\x97\x2a\xc1\x85\x13\x58\xe9\x24\xf1\xca\xbc\x25\xf6\x57\x7f\x6d\x1b\x4c\x05\x0e\x7b\xe6\xd2\x7b\x48\x23\x37\x7b\x6b\x1b\x33\x79\xb1\xcf\x6e\x85\x54\xde\x8a\xa6\xfc\x73\xfb\x6b\xd7\xce\x61\x8f\xed\x12\xff\x58\x9f\x6d\x83\xd5\x7f\xa0\xe7\xb6\x55\x7f\x42\xff\xed\x80\x45\x3e\x63\xbc\xf6\xe1\xf8\xb0\xff\xa1\xcf\x07\x87\x1f\x3f\x70\xfc\x2f\x7f\x79\xbe\xc0\x2a\x18\x9f\x7f\x21\x39\xdc\xda\x7a\xf9\x9f\xb2\xf3\x2e\xbc\x39\x23\x88\x35\x0e\xc2\x5a\xae\x5b\xfa\x4f\x43\xe6\xbd\xa8\x49\x7b\x3b\x05\xb9\x07\x3f\x2c\xf7\xd9\x61\x64\xd7\x0e\xd0\x67\x30\xdd\xb2\xee\x13\x02\xa3\xbb\x1f\x6e\xe5\xde\x78\xf1\xa3\x93\xc1\x63\xb3\x71\x6f\x1a\x7e\xe9\x06\x92\x9f\x3a\x3b\xdc\xd9\xe5\xbb\x27\x01\x6f\xcc\x0f\x2f\xdf\xf6\x71\xc5\xc2\xc0\x69\x4a\x78\x4f\x87\xac\x81\x21\x9e\x06\xfe\x68\x9f\x67\x1d\xb8\x6c\xf9\xc8\x74\x25\xbc\x1e\xe3\x91\xe9\xca\x41\x53\x1c\xff\xc3\x4d\xdd\x99\xdc\xdd\x5a\x5a\x88\x44\x7e\xa2\xe4\xec\x0d\xd6\x3d\xa9\x39\xbb\x90\xe4\xa7\x8a\xcc\xc1\xd0\x70\x57\x68\xee\x1e\xf2\x45\xb6\x37\x49\xbc\x21\x51\xe2\xf4\x10\xcd\xe3\x12\x78\x74\x77\xc7\x95\x9e\xf5\x0d\xc1\xfe\xe2\x8a\xd3\xdf\xdb\x8b\x2c\x90\xff\x03\x31\x09\x6f\x0c\xfe\x39\xdd\x77\x10\xe8\xf8\xe0\xe9\xea\xff\xe6\x95\xa0\x03\x2a\xf6\x44\xc0\xfb\xdc\xed\x6d\xb4\x12\xdc\xf5\xfe\x16\xfb\x7e\xd0\x41\x41\x76\xb8\x23\xfe\x4f\xc0\x48\x1b\x8c\xc6\xcf\x17\x83\xdf\x00\xcd\xf0\xb5\xc3\x60\xbc\xb7\xfd\x8b\xe3\xdf\x10\x0f\x70\x57\xf7\x76\xd7\x8f\xf8\x67\x40\x8f\xe6\x67\x01\xf4\xe0\x9e\xf4\x70\x9f\x1a\x30\x35\xb8\x76\xfe\xc4\xb5\xf1\x0b\x41\x86\x9c\x11\x0c\xaf\xfb\x82\xbb\x93\x11\x0c\x30\x0a\x4c\xa5\x69\x0b\x3e\x5f\x78\x61\x2a\xc8\xde\x75\xd7\xdb\x63\x11\x43\x80\xa8\x2d\xa3\xaf\x9e\xae\xbe\x11\x1b\x05\x4c\xd4\x05\x5f\x69\x8f\xf0\xf1\x5b\x57\x1f\xc1\x6f\x1f\x6f\x73\x8a\xda\x21\x26\x0f\x36\x1c\x48\xe2\xcd\x66\x4f\xc3\x06\x7e\x42\xab\xc1\xc9\x83\x5b\x8d\xee\x4f\xda\xdf\x6c\xee\xf5\xcf\xeb\x12\x3f\xc6\xf2\x36\x63\x60\x89\x3f\x09\xc7\xd7\x6d\xc8\xa7\x5f\xc6\x7f\xbe\x83\xf6\x7f\xdc\xc4\xf5\x68\x2f\xe2\xe5\xc4\xb8\xfd\x7e\xd1\x2c\xb8\xb4\x89\xd0\x86\xb1\x57\xca\x13\x75\xf4\x4f\x8a\xfd\x0a\x4a\x44\xce\x87\x82\x00\xef\x1f\xb0\x69\x81\x21\x78\x0b\xbf\x7f\x39\xdd\x80\x39\x0d\xd9\x3d\x08\x39\xf6\xfd\x38\x44\xa0\xe1\x75\xfc\x70\x9f\x09\x5a\xf5\xf7\xa7\x28\xb1\x8d\x31\xe6\x64\x5a\xd1\xc5\x4b\x97\x80\x07\x51\xfe\x27\xeb\x32\x97\x03\x9f\x03\xcf\x3c\x00\x15\xf8\x91\xd1\x95\x72\x7a\xb9\xcc\x59\x79\xb8\x5e\x05\x7a\xe1\xd2\xd5\x48\x67\x65\x03\x37\xe6\xda\x2b\x8c\xf7\x97\x30\x1e\xcc\x11\x9e\x4e\x6e\x5b\x3c\xaa\x11\x86\xd6\x1f\xbf\x39\x7d\x77\x79\x9b\xbe\x7b\x61\x3a\x27\x5b\xaa\xbc\x03\x7c\xfc\xb6\xf3\xbc\x5f\xee\xc6\x4b\xa0\xfd\x4b\xb1\x2e\xdc\xae\xfe\x77\xff\x4c\xc5\x97\x4b\x97\xac\xef\xea\x1e\x45\xdb\x1f\xe7\x5c\x7e\x0d\xf4\x19\xcb\x4e\xee\xbd\x3c\x2a\xbc\xbd\x17\xf1\xea\x3d\x8e\x27\xeb\x60\xc1\x2b\x75\xaf\x5c\x69\xfe\x14\x5c\xdb\xfd\x14\xbc\xd8\x0a\xde\xcb\x79\xe5\x2d\xd1\x0f\x22\x7e\x76\x8d\xe3\xc3\x3d\xb7\xbd\xdf\x60\xb7\xfe\x7e\xb9\x17\x3f\xfc\x9e\xfb\x14\x8b\xaf\x07\xd1\x1f\xbe\xd7\xe0\x27\x2a\xde\xd1\x5a\xd8\xff\xd7\xba\xff\x15\x5a\x27\x91\xbb\xfb\xce\x4e\x97\x51\x9e\x3e\x76\x93\x96\xb7\xe3\x0b\x26\xae\x35\x77\x7a\xa3\xe2\x15\x0c\xc3\x86\x01\xf8\x60\x95\x00\x09\xa7\xd4\xb7\x5a\x39\xba\x94\xf1\xd2\x95\x9d\x47\x77\xc3\xfd\x09\x3a\x7e\xd7\x3c\x9d\x5e\x64\x72\xb6\x9c\x73\xe5\x6e\xd4\x3f\xde\xce\xc5\xc5\x9d\xf0\x3a\xd8\x1e\xed\x6d\xd9\xfb\x67\xb4\x79\xb2\xd0\x73\xd0\xe8\xb6\x73\xaf\xb7\xfa\x6f\x6a\x35\x01\x34\xff\xca\x41\xf0\x20\xd9\xaa\xf2\xf1\xff\x00\xfd\x01\x5c\x06\x8d\x97\x00\x00")
@@ -0,0 +1,239 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// CheckpointEvent is an event published during the session whose handlers
+// haven't all finished yet
+type CheckpointEvent struct {
+	Topic     string            `json:"topic"`
+	Args      []json.RawMessage `json:"args"`
+	Published int               `json:"published"`
+	Handled   int               `json:"handled"`
+	// Done is only set in session files of older versions, which kept
+	// finished events
+	Done    bool `json:"done,omitempty"`
+	resumed bool
+}
+
+// PendingEvent is an event which has to be published again on resume
+type PendingEvent struct {
+	Topic string
+	Args  []interface{}
+}
+
+// Checkpoint records the events published in the session until all of
+// their handlers have finished. Events published by a handler are recorded
+// before the handler finishes, so the recorded events are exactly the work
+// left when the scan is resumed
+type Checkpoint struct {
+	sync.Mutex
+	Targets     []Target                    `json:"targets"`
+	Reached     []string                    `json:"reached"`
+	Events      map[string]*CheckpointEvent `json:"events"`
+	subscribers map[string]int
+	argTypes    map[string][]reflect.Type
+	targetKeys  map[string]struct{}
+	reachedKeys map[string]struct{}
+	cleared     bool
+}
+
+// NewCheckpoint returns a new empty Checkpoint
+func NewCheckpoint() *Checkpoint {
+	c := &Checkpoint{}
+	c.init()
+	return c
+}
+
+func (c *Checkpoint) init() {
+	if c.Events == nil {
+		c.Events = make(map[string]*CheckpointEvent)
+	}
+	c.subscribers = make(map[string]int)
+	c.argTypes = make(map[string][]reflect.Type)
+	c.targetKeys = make(map[string]struct{})
+	for _, target := range c.Targets {
+		c.targetKeys[target.String()] = struct{}{}
+	}
+	c.reachedKeys = make(map[string]struct{})
+	for _, key := range c.Reached {
+		c.reachedKeys[key] = struct{}{}
+	}
+	for key, event := range c.Events {
+		if event.Done {
+			delete(c.Events, key)
+		}
+	}
+}
+
+func eventKey(topic string, args []interface{}) string {
+	return fmt.Sprintf("%s %v", topic, args)
+}
+
+func isLifecycleTopic(topic string) bool {
+	return topic == SessionStart || topic == SessionEnd
+}
+
+// MarshalJSON encodes the checkpoint while it is locked, as events keep
+// being recorded while the session is checkpointed. A cleared checkpoint
+// is encoded as null
+func (c *Checkpoint) MarshalJSON() ([]byte, error) {
+	type checkpoint Checkpoint
+	c.Lock()
+	defer c.Unlock()
+	if c.cleared {
+		return []byte("null"), nil
+	}
+	return json.Marshal((*checkpoint)(c))
+}
+
+// Clear drops the recorded targets and events once the scan has finished,
+// nothing is recorded afterwards
+func (c *Checkpoint) Clear() {
+	c.Lock()
+	defer c.Unlock()
+	c.cleared = true
+	c.Targets = nil
+	c.Reached = nil
+	c.Events = make(map[string]*CheckpointEvent)
+	c.targetKeys = make(map[string]struct{})
+	c.reachedKeys = make(map[string]struct{})
+}
+
+// Subscribed registers a handler of the topic and the types of its arguments
+func (c *Checkpoint) Subscribed(topic string, handlerType reflect.Type) {
+	c.Lock()
+	defer c.Unlock()
+	c.subscribers[topic]++
+	if _, ok := c.argTypes[topic]; ok {
+		return
+	}
+	types := make([]reflect.Type, handlerType.NumIn())
+	for i := range types {
+		types[i] = handlerType.In(i)
+	}
+	c.argTypes[topic] = types
+}
+
+// AddTarget records an input target, returns false if it was already recorded
+func (c *Checkpoint) AddTarget(target Target) bool {
+	c.Lock()
+	defer c.Unlock()
+	if _, ok := c.targetKeys[target.String()]; ok || c.cleared {
+		return false
+	}
+	c.targetKeys[target.String()] = struct{}{}
+	c.Targets = append(c.Targets, target)
+	return true
+}
+
+// TargetReached records that the target was handed to the agents, its
+// work is then tracked by the events
+func (c *Checkpoint) TargetReached(target Target) {
+	c.Lock()
+	defer c.Unlock()
+	if _, ok := c.reachedKeys[target.String()]; ok || c.cleared {
+		return
+	}
+	c.reachedKeys[target.String()] = struct{}{}
+	c.Reached = append(c.Reached, target.String())
+}
+
+// IsReached returns true if the target was handed to the agents
+func (c *Checkpoint) IsReached(target Target) bool {
+	c.Lock()
+	defer c.Unlock()
+	_, ok := c.reachedKeys[target.String()]
+	return ok
+}
+
+// Published records the event, returns false if it is left to the replay
+// of a resumed session, which publishes the events left unfinished by the
+// interrupted scan once. Events without handlers are not recorded
+func (c *Checkpoint) Published(topic string, args []interface{}) bool {
+	if isLifecycleTopic(topic) {
+		return true
+	}
+	key := eventKey(topic, args)
+	c.Lock()
+	defer c.Unlock()
+	if event, ok := c.Events[key]; ok {
+		if event.resumed {
+			return false
+		}
+		event.Published++
+		return true
+	}
+	if c.subscribers[topic] == 0 || c.cleared {
+		return true
+	}
+	event := &CheckpointEvent{Topic: topic, Published: 1}
+	for _, arg := range args {
+		data, _ := json.Marshal(arg)
+		event.Args = append(event.Args, data)
+	}
+	c.Events[key] = event
+	return true
+}
+
+// Handled marks that one handler of the event has finished, returns true
+// if that was the last one. Finished events are removed from the checkpoint
+func (c *Checkpoint) Handled(topic string, args []interface{}) bool {
+	if isLifecycleTopic(topic) {
+		return false
+	}
+	key := eventKey(topic, args)
+	c.Lock()
+	defer c.Unlock()
+	event, ok := c.Events[key]
+	if !ok {
+		return false
+	}
+	event.Handled++
+	if event.Handled < c.subscribers[topic]*event.Published {
+		return false
+	}
+	delete(c.Events, key)
+	return true
+}
+
+// Pending returns the events which are not done yet with arguments
+// converted back to the types expected by their handlers
+func (c *Checkpoint) Pending() (pending []PendingEvent, err error) {
+	c.Lock()
+	defer c.Unlock()
+	for _, event := range c.Events {
+		types, ok := c.argTypes[event.Topic]
+		if !ok {
+			continue
+		}
+		if len(types) != len(event.Args) {
+			return nil, fmt.Errorf("event %s has %d arguments, expected %d", event.Topic, len(event.Args), len(types))
+		}
+		var args []interface{}
+		for i, data := range event.Args {
+			value := reflect.New(types[i])
+			if err := json.Unmarshal(data, value.Interface()); err != nil {
+				return nil, fmt.Errorf("unable to decode argument of event %s: %s", event.Topic, err)
+			}
+			args = append(args, value.Elem().Interface())
+		}
+		// Partially handled events are handled again by all handlers, once
+		event.Handled = 0
+		event.Published = 1
+		event.resumed = true
+		pending = append(pending, PendingEvent{Topic: event.Topic, Args: args})
+	}
+	return pending, nil
+}
+
+// Unfinished returns the number of recorded events which aren't done yet
+func (c *Checkpoint) Unfinished() int {
+	c.Lock()
+	defer c.Unlock()
+	return len(c.Events)
+}
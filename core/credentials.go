@@ -0,0 +1,216 @@
+package core
+
+import (
+	"bufio"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/textproto"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+)
+
+// AuthRule is the authentication sent to hosts matching a pattern
+type AuthRule struct {
+	Pattern       string
+	Authorization string
+}
+
+// ParseAuthRule returns the rule from a "<host pattern>=basic:<user>:<password>"
+// or "<host pattern>=bearer:<token>" definition. The pattern may contain
+// wildcards, e.g. *.example.com, and * matches all hosts
+func ParseAuthRule(definition string) (AuthRule, error) {
+	pattern, credentials, found := strings.Cut(definition, "=")
+	pattern = strings.ToLower(strings.TrimSpace(pattern))
+	if !found || pattern == "" {
+		return AuthRule{}, fmt.Errorf("invalid auth %q, expected <host pattern>=basic:<user>:<password> or <host pattern>=bearer:<token>", definition)
+	}
+	if _, err := path.Match(pattern, ""); err != nil {
+		return AuthRule{}, fmt.Errorf("invalid auth host pattern %q: %s", pattern, err)
+	}
+	scheme, value, _ := strings.Cut(credentials, ":")
+	switch strings.ToLower(scheme) {
+	case "basic":
+		if !strings.Contains(value, ":") {
+			return AuthRule{}, fmt.Errorf("invalid basic auth for %s, expected basic:<user>:<password>", pattern)
+		}
+		return AuthRule{Pattern: pattern, Authorization: "Basic " + base64.StdEncoding.EncodeToString([]byte(value))}, nil
+	case "bearer":
+		if value == "" {
+			return AuthRule{}, fmt.Errorf("invalid bearer auth for %s, expected bearer:<token>", pattern)
+		}
+		return AuthRule{Pattern: pattern, Authorization: "Bearer " + value}, nil
+	}
+	return AuthRule{}, fmt.Errorf("invalid auth scheme %q for %s, expected basic or bearer", scheme, pattern)
+}
+
+// Matches returns true if the rule applies to the host
+func (r AuthRule) Matches(host string) bool {
+	matched, _ := path.Match(r.Pattern, strings.ToLower(strings.Trim(host, "[]")))
+	return matched
+}
+
+// Cookie is a cookie loaded from a cookie jar file
+type Cookie struct {
+	Domain     string
+	Subdomains bool
+	Path       string
+	Secure     bool
+	Name       string
+	Value      string
+}
+
+// URL returns the URL the cookie is set for
+func (c Cookie) URL() *url.URL {
+	scheme := "http"
+	if c.Secure {
+		scheme = "https"
+	}
+	return &url.URL{Scheme: scheme, Host: c.Domain, Path: c.Path}
+}
+
+// ParseCookieFile reads cookies in the Netscape cookies.txt format used by
+// curl, wget and browser extensions. Expired cookies are left out
+func ParseCookieFile(r io.Reader) ([]Cookie, error) {
+	var cookies []Cookie
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	now := time.Now().Unix()
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		// curl marks HttpOnly cookies with a prefix which looks like a comment
+		line = strings.TrimPrefix(line, "#HttpOnly_")
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, "\t")
+		if len(fields) < 6 {
+			return nil, fmt.Errorf("invalid cookie on line %d, expected tab-separated domain, subdomains, path, secure, expiry, name and value", lineNumber)
+		}
+		value := ""
+		if len(fields) > 6 {
+			value = fields[6]
+		}
+		var expires int64
+		fmt.Sscan(fields[4], &expires)
+		if expires > 0 && expires < now {
+			continue
+		}
+		cookies = append(cookies, Cookie{
+			Domain:     strings.ToLower(strings.TrimPrefix(fields[0], ".")),
+			Subdomains: strings.EqualFold(fields[1], "TRUE"),
+			Path:       fields[2],
+			Secure:     strings.EqualFold(fields[3], "TRUE"),
+			Name:       fields[5],
+			Value:      value,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return cookies, nil
+}
+
+// Credentials holds the custom headers, cookies and authentication
+// sent with requests to the hosts they apply to
+type Credentials struct {
+	Headers map[string]string
+	Cookies []Cookie
+	Auth    []AuthRule
+	jar     *cookiejar.Jar
+}
+
+// NewCredentials returns credentials from "<name>: <value>" headers,
+// cookie jar files and auth rule definitions
+func NewCredentials(headers []string, cookieFiles []string, auth []string) (*Credentials, error) {
+	c := &Credentials{Headers: make(map[string]string)}
+	for _, header := range headers {
+		name, value, found := strings.Cut(header, ":")
+		name = strings.TrimSpace(name)
+		if !found || name == "" {
+			return nil, fmt.Errorf("invalid header %q, expected <name>: <value>", header)
+		}
+		c.Headers[textproto.CanonicalMIMEHeaderKey(name)] = strings.TrimSpace(value)
+	}
+	for _, filename := range cookieFiles {
+		f, err := os.Open(filename)
+		if err != nil {
+			return nil, fmt.Errorf("unable to open cookie file %s: %s", filename, err)
+		}
+		cookies, err := ParseCookieFile(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse cookie file %s: %s", filename, err)
+		}
+		c.Cookies = append(c.Cookies, cookies...)
+	}
+	for _, definition := range auth {
+		rule, err := ParseAuthRule(definition)
+		if err != nil {
+			return nil, err
+		}
+		c.Auth = append(c.Auth, rule)
+	}
+	if _, found := c.Headers["Authorization"]; found && len(c.Auth) > 0 {
+		return nil, fmt.Errorf("an Authorization header can't be combined with auth rules, use one or the other")
+	}
+	if len(c.Cookies) > 0 {
+		c.jar, _ = cookiejar.New(nil)
+		for _, cookie := range c.Cookies {
+			httpCookie := &http.Cookie{
+				Name:   cookie.Name,
+				Value:  cookie.Value,
+				Path:   cookie.Path,
+				Secure: cookie.Secure,
+			}
+			if cookie.Subdomains {
+				httpCookie.Domain = cookie.Domain
+			}
+			c.jar.SetCookies(cookie.URL(), []*http.Cookie{httpCookie})
+		}
+	}
+	return c, nil
+}
+
+// Authorization returns the Authorization header value for the host,
+// taken from the first matching auth rule
+func (c *Credentials) Authorization(host string) string {
+	for _, rule := range c.Auth {
+		if rule.Matches(host) {
+			return rule.Authorization
+		}
+	}
+	return ""
+}
+
+// RequestHeaders returns the custom headers along with the Authorization
+// and Cookie headers which apply to the URL. Cookies from the jar are
+// added to a custom Cookie header
+func (c *Credentials) RequestHeaders(u *url.URL) map[string]string {
+	headers := make(map[string]string)
+	for name, value := range c.Headers {
+		headers[name] = value
+	}
+	if authorization := c.Authorization(u.Hostname()); authorization != "" {
+		headers["Authorization"] = authorization
+	}
+	if c.jar != nil {
+		var cookies []string
+		for _, cookie := range c.jar.Cookies(u) {
+			cookies = append(cookies, cookie.String())
+		}
+		if len(cookies) > 0 {
+			if value := headers["Cookie"]; value != "" {
+				cookies = append([]string{value}, cookies...)
+			}
+			headers["Cookie"] = strings.Join(cookies, "; ")
+		}
+	}
+	return headers
+}
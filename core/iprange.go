@@ -0,0 +1,119 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// IPRange is an inclusive range of IP addresses
+type IPRange struct {
+	First net.IP
+	Last  net.IP
+}
+
+// ParseIPRange parses a single address, a CIDR block (10.0.0.0/24),
+// a full dash range (10.0.0.1-10.0.0.50) or a dash range of the last
+// octet or IPv6 group (10.0.0.1-50, 2001:db8::1-ff)
+func ParseIPRange(s string) (*IPRange, error) {
+	s = strings.TrimSpace(s)
+	if strings.Contains(s, "/") {
+		_, network, err := net.ParseCIDR(s)
+		if err != nil {
+			return nil, err
+		}
+		first := normalizeIP(network.IP)
+		last := make(net.IP, len(first))
+		for i := range first {
+			last[i] = first[i] | ^network.Mask[i]
+		}
+		return &IPRange{First: first, Last: last}, nil
+	}
+
+	parts := strings.SplitN(s, "-", 2)
+	first := net.ParseIP(parts[0])
+	if first == nil {
+		return nil, fmt.Errorf("invalid IP address: %s", parts[0])
+	}
+	first = normalizeIP(first)
+	if len(parts) == 1 {
+		return &IPRange{First: first, Last: first}, nil
+	}
+
+	last := net.ParseIP(parts[1])
+	if last == nil {
+		last = make(net.IP, len(first))
+		copy(last, first)
+		if first.To4() != nil && len(first) == net.IPv4len {
+			n, err := strconv.ParseUint(parts[1], 10, 8)
+			if err != nil {
+				return nil, fmt.Errorf("invalid IP range end: %s", parts[1])
+			}
+			last[3] = byte(n)
+		} else {
+			n, err := strconv.ParseUint(parts[1], 16, 16)
+			if err != nil {
+				return nil, fmt.Errorf("invalid IP range end: %s", parts[1])
+			}
+			last[14] = byte(n >> 8)
+			last[15] = byte(n)
+		}
+	}
+	last = normalizeIP(last)
+	if len(first) != len(last) {
+		return nil, fmt.Errorf("IP range mixes IPv4 and IPv6 addresses: %s", s)
+	}
+	if bytes.Compare(first, last) > 0 {
+		return nil, fmt.Errorf("IP range end is lower than its start: %s", s)
+	}
+	return &IPRange{First: first, Last: last}, nil
+}
+
+// Contains returns true if the address is in the range
+func (r *IPRange) Contains(ip net.IP) bool {
+	ip = normalizeIP(ip)
+	if len(ip) != len(r.First) {
+		return false
+	}
+	return bytes.Compare(ip, r.First) >= 0 && bytes.Compare(ip, r.Last) <= 0
+}
+
+// Size returns number of addresses in the range
+func (r *IPRange) Size() *big.Int {
+	size := new(big.Int).Sub(new(big.Int).SetBytes(r.Last), new(big.Int).SetBytes(r.First))
+	return size.Add(size, big.NewInt(1))
+}
+
+// Expand returns all addresses in the range or an error if there are more than limit
+func (r *IPRange) Expand(limit int) ([]string, error) {
+	if limit > 0 && r.Size().Cmp(big.NewInt(int64(limit))) > 0 {
+		return nil, fmt.Errorf("IP range %s-%s contains %s addresses which is more than the limit of %d", r.First, r.Last, r.Size(), limit)
+	}
+	var addrs []string
+	ip := make(net.IP, len(r.First))
+	copy(ip, r.First)
+	for {
+		addrs = append(addrs, ip.String())
+		if ip.Equal(r.Last) {
+			break
+		}
+		for i := len(ip) - 1; i >= 0; i-- {
+			ip[i]++
+			if ip[i] != 0 {
+				break
+			}
+		}
+	}
+	return addrs, nil
+}
+
+// normalizeIP returns 4 byte representation of IPv4 addresses
+func normalizeIP(ip net.IP) net.IP {
+	if ip4 := ip.To4(); ip4 != nil {
+		return ip4
+	}
+	return ip.To16()
+}
@@ -2,7 +2,6 @@ package core
 
 import (
 	"fmt"
-	"os"
 	"sync"
 
 	"github.com/fatih/color"
@@ -59,13 +58,9 @@ func (l *Logger) Log(level int, format string, args ...interface{}) {
 	} else {
 		fmt.Printf(format, args...)
 	}
-
-	if level == FATAL {
-		os.Exit(1)
-	}
 }
 
-// Fatal to log and exit with code 1
+// Fatal to log in bold red, exiting is left to the caller
 func (l *Logger) Fatal(format string, args ...interface{}) {
 	l.Log(FATAL, format, args...)
 }
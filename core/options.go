@@ -6,46 +6,144 @@ import (
 	"strings"
 )
 
+// StringList is a flag value which can be set multiple times
+type StringList []string
+
+// String returns the values joined with a comma
+func (l *StringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+// Set appends the value to the list
+func (l *StringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
 // Options for arguments
 type Options struct {
-	Threads           *int
-	OutDir            *string
-	SessionPath       *string
-	TemplatePath      *string
-	Proxy             *string
-	BrowserPath       *string
-	Resolution        *string
-	Ports             *string
-	ScanTimeout       *int
-	HTTPTimeout       *int
-	ScreenshotTimeout *int
-	Nmap              *bool
-	SaveBody          *bool
-	Silent            *bool
-	Debug             *bool
-	Version           *bool
+	Threads            *int
+	ScanThreads        *int
+	HTTPThreads        *int
+	ScreenshotThreads  *int
+	FingerprintThreads *int
+	OutDir             *string
+	SessionPath        *string
+	Resume             *string
+	CheckpointInterval *int
+	TemplatePath       *string
+	ScopePath          *string
+	Proxy              *string
+	HTTPProxy          *string
+	HTTPSProxy         *string
+	BrowserPath        *string
+	Resolution         *string
+	Ports              *string
+	FollowRedirects    *bool
+	SpoofHeaders       *string
+	PublishFinalURL    *bool
+	ScanTimeout        *int
+	HTTPTimeout        *int
+	ScreenshotTimeout  *int
+	MaxRuntime         *int
+	HookTimeout        *int
+	Rate               *float64
+	HostRate           *float64
+	Jitter             *int
+	Nmap               *bool
+	InputFormat        *string
+	SaveBody           *bool
+	MaxBodySize        *int
+	Silent             *bool
+	Debug              *bool
+	Version            *bool
+	Agents             *string
+	SkipAgents         *string
+	Inputs             *StringList
+	Hooks              *StringList
+	Headers            *StringList
+	CookieFiles        *StringList
+	Auth               *StringList
+	Exclude            *StringList
+	MaxRangeSize       *int
+	DeepPaths          *bool
+	Stream             *bool
+	VHostIP            *string
+	NmapVHosts         *bool
+	Targets            []string
 }
 
 // ParseOptions from arguments
 func ParseOptions() (Options, error) {
-	options := Options{
-		Version:           flag.Bool("version", false, "Print current Aquasily version"),
-		OutDir:            flag.String("out", ".", "Directory to write files to"),
-		Threads:           flag.Int("threads", 0, "Number of concurrent threads (default number of logical CPUs)"),
-		Ports:             flag.String("ports", strings.Trim(strings.Join(strings.Fields(fmt.Sprint(MediumPortList)), ","), "[]"), "Ports to scan on hosts. Supported list aliases: small, medium, large, xlarge"),
-		ScanTimeout:       flag.Int("scan-timeout", 600, "Timeout in milliseconds for port scans"),
-		Nmap:              flag.Bool("nmap", false, "Parse input as Nmap/Masscan XML"),
-		BrowserPath:       flag.String("browser", "", "Full path to the Chrome/Chromium/Edge executable to use. By default, aquasily will search for Chrome or Chromium"),
-		Resolution:        flag.String("resolution", "1200,900", "Screenshot resolution"),
-		Proxy:             flag.String("proxy", "", "Proxy to use for HTTP requests"),
-		HTTPTimeout:       flag.Int("http-timeout", 3*1000, "Timeout in milliseconds for HTTP requests"),
-		ScreenshotTimeout: flag.Int("screenshot-timeout", 15, "Timeout in seconds for screenshots"),
-		Silent:            flag.Bool("silent", false, "Suppress all output except for errors"),
-		Debug:             flag.Bool("debug", false, "Print debugging information"),
-		SaveBody:          flag.Bool("save-body", true, "Save response bodies to files"),
-		SessionPath:       flag.String("session", "", "Load Aquasily session file and generate HTML report"),
-		TemplatePath:      flag.String("template", "", "Path to HTML template to use for report"),
-	}
+	options := NewOptions(flag.CommandLine)
 	flag.Parse()
+	options.Targets = flag.Args()
 	return options, nil
 }
+
+// DefaultOptions returns options with default values without
+// touching the command-line flags, for using aquasily as a library
+func DefaultOptions() Options {
+	return NewOptions(flag.NewFlagSet("aquasily", flag.ContinueOnError))
+}
+
+// NewOptions defines all options in the flag set and returns them
+func NewOptions(fs *flag.FlagSet) Options {
+	options := Options{
+		Version:            fs.Bool("version", false, "Print current Aquasily version"),
+		OutDir:             fs.String("out", ".", "Directory to write files to"),
+		Threads:            fs.Int("threads", 0, "Number of concurrent threads (default number of logical CPUs)"),
+		ScanThreads:        fs.Int("scan-threads", 0, "Number of concurrent port scans (default -threads)"),
+		HTTPThreads:        fs.Int("http-threads", 0, "Number of concurrent HTTP requests and hostname lookups (default -threads)"),
+		ScreenshotThreads:  fs.Int("screenshot-threads", 0, "Number of concurrent browser screenshots (default -threads)"),
+		FingerprintThreads: fs.Int("fingerprint-threads", 0, "Number of concurrent page title extractions and technology fingerprints (default -threads)"),
+		Ports:              fs.String("ports", strings.Trim(strings.Join(strings.Fields(fmt.Sprint(MediumPortList)), ","), "[]"), "Ports to scan on hosts. Supported list aliases: small, medium, large, xlarge"),
+		ScanTimeout:        fs.Int("scan-timeout", 600, "Timeout in milliseconds for port scans"),
+		Nmap:               fs.Bool("nmap", false, "Force parsing input as Nmap/Masscan XML (detected automatically by default)"),
+		InputFormat:        fs.String("input-format", "auto", "Format of the input: auto, text, nmap, masscan-json, masscan-list, har, burp, zap (auto detects the format from content)"),
+		BrowserPath:        fs.String("browser", "", "Full path to the Chrome/Chromium/Edge executable to use. By default, aquasily will search for Chrome or Chromium"),
+		Resolution:         fs.String("resolution", "1200,900", "Screenshot resolution"),
+		Proxy:              fs.String("proxy", "", "Proxy for all traffic as http://, https:// or socks5:// URL, credentials can be given as user:password@"),
+		HTTPProxy:          fs.String("http-proxy", "", "Proxy for http URLs, replaces -proxy for them"),
+		HTTPSProxy:         fs.String("https-proxy", "", "Proxy for https URLs, replaces -proxy for them"),
+		HTTPTimeout:        fs.Int("http-timeout", 3*1000, "Timeout in milliseconds for HTTP requests"),
+		ScreenshotTimeout:  fs.Int("screenshot-timeout", 15, "Timeout in seconds for screenshots"),
+		MaxRuntime:         fs.Int("max-runtime", 0, "Maximum runtime of the scan in minutes, a partial report is written when reached (0 for no limit)"),
+		HookTimeout:        fs.Int("hook-timeout", 60, "Timeout in seconds for commands run by -hook"),
+		Rate:               fs.Float64("rate", 0, "Maximum number of port dials, requests and screenshots per second in total (0 for no limit)"),
+		HostRate:           fs.Float64("host-rate", 0, "Maximum number of port dials, requests and screenshots per second against a single host (0 for no limit)"),
+		Jitter:             fs.Int("jitter", 0, "Maximum random delay in milliseconds added before each port dial, request and screenshot"),
+		Silent:             fs.Bool("silent", false, "Suppress all output except for errors"),
+		Debug:              fs.Bool("debug", false, "Print debugging information"),
+		SaveBody:           fs.Bool("save-body", true, "Save response bodies to files"),
+		MaxBodySize:        fs.Int("max-body-size", 10, "Maximum size in megabytes of response bodies which are read, larger bodies are truncated (0 for no limit)"),
+		SpoofHeaders:       fs.String("spoof-headers", "none", "Spoofed X-Forwarded-For, Via and Forwarded headers: none, random, fixed:<ip>, fixed:<header>=<ip>,... or cycle:<ip>,<ip>,..."),
+		FollowRedirects:    fs.Bool("follow-redirects", true, "Follow redirects in requests, screenshots and fingerprints, every hop is recorded on the page"),
+		PublishFinalURL:    fs.Bool("publish-final-url", false, "Process the final URL of a followed redirect chain as its own page"),
+		SessionPath:        fs.String("session", "", "Load Aquasily session file and generate HTML report"),
+		Resume:             fs.String("resume", "", "Resume an interrupted scan from its aquasily_session.json file"),
+		CheckpointInterval: fs.Int("checkpoint-interval", 60, "Interval in seconds to write the session file during the scan, 0 to disable"),
+		TemplatePath:       fs.String("template", "", "Path to HTML template to use for report"),
+		ScopePath:          fs.String("scope", "", "Path to scope file with domains, CIDR blocks and URL regexes to include or exclude"),
+		MaxRangeSize:       fs.Int("max-range-size", 65536, "Maximum number of addresses a CIDR block or IP range in the input is expanded to"),
+		DeepPaths:          fs.Bool("deep-paths", false, "Keep full URL paths from Burp Suite/ZAP exports instead of only their base URLs"),
+		Stream:             fs.Bool("stream", false, "Process targets from stdin line by line as they arrive instead of reading all input first"),
+		VHostIP:            fs.String("vhost-ip", "", "IP address to connect to for all hostnames, which are sent as Host header and SNI"),
+		NmapVHosts:         fs.Bool("nmap-vhosts", false, "Connect to the IP address from Nmap input for its hostnames instead of resolving them"),
+		Agents:             fs.String("agents", "", "Comma-separated list of agents to run (default all agents)"),
+		SkipAgents:         fs.String("skip-agents", "", "Comma-separated list of agents not to run, e.g. url_screenshotter"),
+		Inputs:             &StringList{},
+		Exclude:            &StringList{},
+		Hooks:              &StringList{},
+		Headers:            &StringList{},
+		CookieFiles:        &StringList{},
+		Auth:               &StringList{},
+	}
+	fs.Var(options.Inputs, "input", "File to read hosts/urls from, glob patterns are allowed, - for stdin (can be repeated)")
+	fs.Var(options.Exclude, "exclude", "Comma-separated IP addresses, CIDR blocks or IP ranges to never touch (can be repeated)")
+	fs.Var(options.Hooks, "hook", "Command to run on an event as <event>:<command>, event is url or port, gets JSON on stdin and may print JSON tags and notes (can be repeated)")
+	fs.Var(options.Headers, "header", "Header to send with every request and screenshot as \"<name>: <value>\" (can be repeated)")
+	fs.Var(options.CookieFiles, "cookies", "Cookie jar file in Netscape cookies.txt format to send cookies from (can be repeated)")
+	fs.Var(options.Auth, "auth", "Authentication for hosts matching a pattern as <host pattern>=basic:<user>:<password> or <host pattern>=bearer:<token> (can be repeated)")
+	return options
+}
@@ -2,6 +2,7 @@ package core
 
 import (
 	"crypto/sha1"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net"
@@ -97,23 +98,61 @@ type Note struct {
 	Type string `json:"type"`
 }
 
+// Redirect is a redirect response received on the way to a page
+type Redirect struct {
+	URL      string   `json:"url"`
+	Status   string   `json:"status"`
+	Location string   `json:"location"`
+	Headers  []Header `json:"headers"`
+}
+
+// NewRedirect returns a redirect with the security flags of its headers set
+func NewRedirect(url string, status string, location string, headers []Header) Redirect {
+	for i := range headers {
+		headers[i].SetSecurityFlags()
+	}
+	return Redirect{
+		URL:      url,
+		Status:   status,
+		Location: location,
+		Headers:  headers,
+	}
+}
+
+// Annotation holds tags and notes to add to a page
+type Annotation struct {
+	Tags  []Tag  `json:"tags"`
+	Notes []Note `json:"notes"`
+}
+
 // Page structure
 type Page struct {
 	sync.Mutex
-	UUID           string   `json:"uuid"`
-	URL            string   `json:"url"`
-	Hostname       string   `json:"hostname"`
-	Addrs          []string `json:"addrs"`
-	Status         string   `json:"status"`
-	PageTitle      string   `json:"pageTitle"`
-	PageStructure  []string `json:"-"`
-	HeadersPath    string   `json:"headersPath"`
-	BodyPath       string   `json:"bodyPath"`
-	ScreenshotPath string   `json:"screenshotPath"`
-	HasScreenshot  bool     `json:"hasScreenshot"`
-	Headers        []Header `json:"headers"`
-	Tags           []Tag    `json:"tags"`
-	Notes          []Note   `json:"notes"`
+	UUID           string     `json:"uuid"`
+	URL            string     `json:"url"`
+	Hostname       string     `json:"hostname"`
+	Addrs          []string   `json:"addrs"`
+	Status         string     `json:"status"`
+	FinalURL       string     `json:"finalURL"`
+	Redirects      []Redirect `json:"redirects"`
+	PageTitle      string     `json:"pageTitle"`
+	PageStructure  []string   `json:"-"`
+	HeadersPath    string     `json:"headersPath"`
+	BodyPath       string     `json:"bodyPath"`
+	ScreenshotPath string     `json:"screenshotPath"`
+	HasScreenshot  bool       `json:"hasScreenshot"`
+	Headers        []Header   `json:"headers"`
+	RequestHeaders []Header   `json:"requestHeaders"`
+	Tags           []Tag      `json:"tags"`
+	Notes          []Note     `json:"notes"`
+}
+
+// MarshalJSON encodes the page while it is locked
+func (p *Page) MarshalJSON() ([]byte, error) {
+	type page Page
+	p.Lock()
+	defer p.Unlock()
+	return json.Marshal((*page)(p))
 }
 
 // AddHeader to Headers list
@@ -125,6 +164,11 @@ func (p *Page) AddHeader(name string, value string) {
 		Value: value,
 	}
 	header.SetSecurityFlags()
+	for _, h := range p.Headers {
+		if h == header {
+			return
+		}
+	}
 	p.Headers = append(p.Headers, header)
 }
 
@@ -138,11 +182,17 @@ func (p *Page) AddTag(text string, tagType string, link string) {
 	io.WriteString(h, tagType)
 	io.WriteString(h, link)
 
+	hash := fmt.Sprintf("%x", h.Sum(nil))
+	for _, tag := range p.Tags {
+		if tag.Hash == hash {
+			return
+		}
+	}
 	p.Tags = append(p.Tags, Tag{
 		Text: text,
 		Type: tagType,
 		Link: link,
-		Hash: fmt.Sprintf("%x", h.Sum(nil)),
+		Hash: hash,
 	})
 }
 
@@ -150,10 +200,52 @@ func (p *Page) AddTag(text string, tagType string, link string) {
 func (p *Page) AddNote(text string, noteType string) {
 	p.Lock()
 	defer p.Unlock()
-	p.Notes = append(p.Notes, Note{
+	note := Note{
 		Text: text,
 		Type: noteType,
-	})
+	}
+	for _, n := range p.Notes {
+		if n == note {
+			return
+		}
+	}
+	p.Notes = append(p.Notes, note)
+}
+
+// Annotate adds all tags and notes of the annotation
+func (p *Page) Annotate(a Annotation) {
+	for _, tag := range a.Tags {
+		p.AddTag(tag.Text, tag.Type, tag.Link)
+	}
+	for _, note := range a.Notes {
+		p.AddNote(note.Text, note.Type)
+	}
+}
+
+// Port returns the port of the page URL, or the default port of its scheme
+func (p *Page) Port() string {
+	u := p.ParsedURL()
+	if u.Port() != "" {
+		return u.Port()
+	}
+	if u.Scheme == "https" {
+		return "443"
+	}
+	return "80"
+}
+
+// AddTargetInfo adds tags and a note with what the input knew about the page
+func (p *Page) AddTargetInfo(t *Target) {
+	if len(t.Metadata) == 0 {
+		return
+	}
+	if service := t.Metadata["service"]; service != "" {
+		p.AddTag(service, "secondary", "")
+	}
+	if product := strings.TrimSpace(t.Metadata["product"] + " " + t.Metadata["version"]); product != "" {
+		p.AddTag(product, "secondary", "")
+	}
+	p.AddNote(fmt.Sprintf("%s: %s", t.Source, t.Describe()), "info")
 }
 
 // BaseFilename for the page
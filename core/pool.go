@@ -0,0 +1,105 @@
+package core
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Names of the worker pools
+const (
+	PortScanPool    = "ports"
+	HTTPPool        = "http"
+	ScreenshotPool  = "screenshots"
+	FingerprintPool = "fingerprint"
+)
+
+// PoolNames lists the worker pools in pipeline order
+var PoolNames = []string{PortScanPool, HTTPPool, ScreenshotPool, FingerprintPool}
+
+// Pool limits the number of concurrent tasks of a pipeline stage
+// and keeps track of how busy the stage was
+type Pool struct {
+	SizedWaitGroup
+	Name       string
+	mutex      sync.Mutex
+	active     int
+	peak       int
+	tasks      int
+	busy       time.Duration
+	started    time.Time
+	lastChange time.Time
+}
+
+// NewPool returns a pool running at most size tasks at once
+func NewPool(name string, size int) *Pool {
+	now := time.Now()
+	return &Pool{
+		SizedWaitGroup: New(size),
+		Name:           name,
+		started:        now,
+		lastChange:     now,
+	}
+}
+
+// update accumulates the time spent by the active tasks since the last change
+func (p *Pool) update(delta int) {
+	now := time.Now()
+	p.busy += time.Duration(p.active) * now.Sub(p.lastChange)
+	p.lastChange = now
+	p.active += delta
+}
+
+// Add blocks until a slot in the pool is free and takes it
+func (p *Pool) Add() {
+	p.AddWithContext(context.Background())
+}
+
+// AddWithContext blocks until a slot in the pool is free and takes it,
+// or returns an error if the context is done first
+func (p *Pool) AddWithContext(ctx context.Context) error {
+	if err := p.SizedWaitGroup.AddWithContext(ctx); err != nil {
+		return err
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.update(1)
+	p.tasks++
+	if p.active > p.peak {
+		p.peak = p.active
+	}
+	return nil
+}
+
+// Done frees the slot taken by Add
+func (p *Pool) Done() {
+	p.mutex.Lock()
+	p.update(-1)
+	p.mutex.Unlock()
+	p.SizedWaitGroup.Done()
+}
+
+// PoolStats describes how the pool was used
+type PoolStats struct {
+	Size        int     `json:"size"`
+	Tasks       int     `json:"tasks"`
+	Peak        int     `json:"peak"`
+	Utilisation float64 `json:"utilisation"`
+}
+
+// Stats returns the usage of the pool so far, utilisation is the share
+// of the pool's capacity which was busy since the pool was created
+func (p *Pool) Stats() PoolStats {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.update(0)
+	stats := PoolStats{
+		Size:  p.Size,
+		Tasks: p.tasks,
+		Peak:  p.peak,
+	}
+	if elapsed := p.lastChange.Sub(p.started); elapsed > 0 {
+		stats.Utilisation = float64(p.busy) / (float64(p.Size) * float64(elapsed))
+	}
+	return stats
+}
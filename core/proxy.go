@@ -0,0 +1,238 @@
+package core
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/net/proxy"
+)
+
+// Proxies holds the proxy for all traffic and the ones which replace it
+// for requests to http and https URLs. A nil proxy means direct connections
+type Proxies struct {
+	All   *url.URL
+	HTTP  *url.URL
+	HTTPS *url.URL
+}
+
+// ParseProxy returns the proxy URL if it is an http, https or socks5 proxy,
+// or nil if raw is empty. Credentials are given as user:password@
+func ParseProxy(raw string) (*url.URL, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy %s: %s", raw, err)
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("invalid proxy %s, expected an http://, https:// or socks5:// URL", raw)
+	}
+	if u.Hostname() == "" {
+		return nil, fmt.Errorf("invalid proxy %s, missing host", raw)
+	}
+	if u.Port() == "" {
+		port := "1080"
+		if u.Scheme == "http" {
+			port = "80"
+		} else if u.Scheme == "https" {
+			port = "443"
+		}
+		u.Host = net.JoinHostPort(u.Hostname(), port)
+	}
+	return u, nil
+}
+
+// NewProxies returns the proxies from the proxy for all traffic and the
+// ones for http and https URLs, any of which may be empty
+func NewProxies(all string, httpProxy string, httpsProxy string) (*Proxies, error) {
+	var err error
+	p := &Proxies{}
+	if p.All, err = ParseProxy(all); err != nil {
+		return nil, err
+	}
+	if p.HTTP, err = ParseProxy(httpProxy); err != nil {
+		return nil, err
+	}
+	if p.HTTPS, err = ParseProxy(httpsProxy); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Enabled returns true if any traffic goes through a proxy
+func (p *Proxies) Enabled() bool {
+	return p.All != nil || p.HTTP != nil || p.HTTPS != nil
+}
+
+// ForScheme returns the proxy for URLs with the scheme, or nil
+func (p *Proxies) ForScheme(scheme string) *url.URL {
+	switch strings.ToLower(scheme) {
+	case "http":
+		if p.HTTP != nil {
+			return p.HTTP
+		}
+	case "https":
+		if p.HTTPS != nil {
+			return p.HTTPS
+		}
+	}
+	return p.All
+}
+
+// ForRequest returns the proxy for the request, to be used as Proxy of an http.Transport
+func (p *Proxies) ForRequest(req *http.Request) (*url.URL, error) {
+	return p.ForScheme(req.URL.Scheme), nil
+}
+
+// ForDial returns the proxy for connections which aren't HTTP requests,
+// like port scans and TLS probes. That is the proxy for all traffic, or
+// the one for https and then the one for http URLs, or nil
+func (p *Proxies) ForDial() *url.URL {
+	for _, u := range []*url.URL{p.All, p.HTTPS, p.HTTP} {
+		if u != nil {
+			return u
+		}
+	}
+	return nil
+}
+
+// DialContext connects to the address through the proxy for connections
+// which aren't HTTP requests, or directly if there is none. HTTP proxies
+// are asked to open a tunnel with CONNECT
+func (p *Proxies) DialContext(ctx context.Context, dialer *net.Dialer, network string, addr string) (net.Conn, error) {
+	proxyURL := p.ForDial()
+	if proxyURL == nil {
+		return dialer.DialContext(ctx, network, addr)
+	}
+	if proxyURL.Scheme == "socks5" {
+		var auth *proxy.Auth
+		if proxyURL.User != nil {
+			password, _ := proxyURL.User.Password()
+			auth = &proxy.Auth{User: proxyURL.User.Username(), Password: password}
+		}
+		socks, err := proxy.SOCKS5("tcp", proxyURL.Host, auth, dialer)
+		if err != nil {
+			return nil, err
+		}
+		return socks.(proxy.ContextDialer).DialContext(ctx, network, addr)
+	}
+	return connectTunnel(ctx, dialer, proxyURL, addr)
+}
+
+// connectTunnel opens a tunnel to the address through an HTTP proxy
+func connectTunnel(ctx context.Context, dialer *net.Dialer, proxyURL *url.URL, addr string) (net.Conn, error) {
+	conn, err := dialer.DialContext(ctx, "tcp", proxyURL.Host)
+	if err != nil {
+		return nil, err
+	}
+	if proxyURL.Scheme == "https" {
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: proxyURL.Hostname(), InsecureSkipVerify: true})
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		conn = tlsConn
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	} else if dialer.Timeout > 0 {
+		conn.SetDeadline(time.Now().Add(dialer.Timeout))
+	}
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: addr},
+		Host:   addr,
+		Header: make(http.Header),
+	}
+	if proxyURL.User != nil {
+		password, _ := proxyURL.User.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(proxyURL.User.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+credentials)
+	}
+	if err := req.Write(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, req)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("proxy refused to connect to %s: %s", addr, resp.Status)
+	}
+	conn.SetDeadline(time.Time{})
+	if reader.Buffered() > 0 {
+		return &bufferedConn{Conn: conn, reader: reader}, nil
+	}
+	return conn, nil
+}
+
+// bufferedConn is a connection with data which was already read into a buffer
+type bufferedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (c *bufferedConn) Read(b []byte) (int, error) {
+	return c.reader.Read(b)
+}
+
+// BrowserProxyServer returns the value of the Chrome --proxy-server flag,
+// or an empty string if no proxy is used. Chrome takes credentials of
+// HTTP proxies through authentication challenges, see BrowserCredentials
+func (p *Proxies) BrowserProxyServer() string {
+	server := func(u *url.URL) string {
+		return u.Scheme + "://" + u.Host
+	}
+	if p.HTTP == nil && p.HTTPS == nil {
+		if p.All == nil {
+			return ""
+		}
+		return server(p.All)
+	}
+	var rules []string
+	for _, scheme := range []string{"http", "https"} {
+		if u := p.ForScheme(scheme); u != nil {
+			rules = append(rules, scheme+"="+server(u))
+		}
+	}
+	if p.All != nil {
+		rules = append(rules, server(p.All))
+	}
+	return strings.Join(rules, ";")
+}
+
+// BrowserCredentials returns the user name and password the browser answers
+// proxy authentication challenges with, taken from the first proxy which has
+// them. An error is returned for SOCKS5 proxies with credentials, which Chrome
+// can't use
+func (p *Proxies) BrowserCredentials() (username string, password string, err error) {
+	for _, u := range []*url.URL{p.HTTP, p.HTTPS, p.All} {
+		if u == nil || u.User == nil {
+			continue
+		}
+		if u.Scheme == "socks5" {
+			return "", "", fmt.Errorf("the browser doesn't support SOCKS5 proxies with credentials: %s", u.Redacted())
+		}
+		if username == "" {
+			username = u.User.Username()
+			password, _ = u.User.Password()
+		}
+	}
+	return username, password, nil
+}
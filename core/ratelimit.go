@@ -0,0 +1,164 @@
+package core
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+)
+
+// limiter spaces out actions evenly at a fixed rate
+type limiter struct {
+	interval time.Duration
+	next     time.Time
+}
+
+// reserve returns the time at which the next action may run
+func (l *limiter) reserve(now time.Time) time.Time {
+	if l.next.Before(now) {
+		l.next = now
+	}
+	at := l.next
+	l.next = l.next.Add(l.interval)
+	return at
+}
+
+// schedule spaces out actions at a fixed rate like limiter, but books each
+// action into the first free slot at or after the time it is ready, so an
+// action held back by another limit doesn't delay the ones after it
+type schedule struct {
+	interval time.Duration
+	slots    []time.Time
+}
+
+// reserve returns the time at which an action ready at ready may run
+func (s *schedule) reserve(now time.Time, ready time.Time) time.Time {
+	// Slots an interval in the past can't collide with new actions anymore
+	passed := 0
+	for passed < len(s.slots) && !s.slots[passed].After(now.Add(-s.interval)) {
+		passed++
+	}
+	s.slots = s.slots[passed:]
+	at := ready
+	position := len(s.slots)
+	for i, slot := range s.slots {
+		if !slot.After(at.Add(-s.interval)) {
+			continue
+		}
+		if !slot.Before(at.Add(s.interval)) {
+			position = i
+			break
+		}
+		at = slot.Add(s.interval)
+	}
+	s.slots = append(s.slots, time.Time{})
+	copy(s.slots[position+1:], s.slots[position:])
+	s.slots[position] = at
+	return at
+}
+
+func rateInterval(rate float64) time.Duration {
+	if rate <= 0 {
+		return 0
+	}
+	return time.Duration(float64(time.Second) / rate)
+}
+
+// RateLimiter limits the number of actions per second globally and against
+// each host, with an optional random delay added to every action
+type RateLimiter struct {
+	mutex     sync.Mutex
+	global    *schedule
+	perHost   time.Duration
+	hosts     map[string]*limiter
+	pruneSize int
+	jitter    time.Duration
+}
+
+// minPruneSize is the number of hosts tracked before idle ones are dropped
+const minPruneSize = 1024
+
+// NewRateLimiter returns a RateLimiter allowing rate actions per second in
+// total and hostRate actions per second against a single host, 0 means no
+// limit. Every action is delayed by up to jitter on top of that
+func NewRateLimiter(rate float64, hostRate float64, jitter time.Duration) *RateLimiter {
+	r := &RateLimiter{
+		perHost:   rateInterval(hostRate),
+		hosts:     make(map[string]*limiter),
+		pruneSize: minPruneSize,
+		jitter:    jitter,
+	}
+	if rate > 0 {
+		r.global = &schedule{interval: rateInterval(rate)}
+	}
+	return r
+}
+
+// Enabled returns true if the limiter delays anything at all
+func (r *RateLimiter) Enabled() bool {
+	return r.global != nil || r.perHost > 0 || r.jitter > 0
+}
+
+// LimitsHosts returns true if actions against a single host are limited
+func (r *RateLimiter) LimitsHosts() bool {
+	return r.perHost > 0
+}
+
+// Wait blocks until an action against the host is allowed, or returns an
+// error if the context is done first. The host slot is taken first, then
+// the first global slot from then on, so both limits hold
+func (r *RateLimiter) Wait(ctx context.Context, host string) error {
+	if !r.Enabled() {
+		return nil
+	}
+	now := time.Now()
+	at := now
+	r.mutex.Lock()
+	if r.perHost > 0 {
+		key := strings.ToLower(host)
+		l, ok := r.hosts[key]
+		if !ok {
+			r.prune(now)
+			l = &limiter{interval: r.perHost}
+			r.hosts[key] = l
+		}
+		at = l.reserve(now)
+	}
+	if r.global != nil {
+		at = r.global.reserve(now, at)
+	}
+	r.mutex.Unlock()
+	if r.jitter > 0 {
+		at = at.Add(time.Duration(rand.Int63n(int64(r.jitter))))
+	}
+	delay := time.Until(at)
+	if delay <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// prune drops the hosts whose next slot has passed once there are too many
+// of them, they start over with a new limiter when they come back
+func (r *RateLimiter) prune(now time.Time) {
+	if len(r.hosts) < r.pruneSize {
+		return
+	}
+	for key, l := range r.hosts {
+		if l.next.Before(now) {
+			delete(r.hosts, key)
+		}
+	}
+	r.pruneSize = 2 * len(r.hosts)
+	if r.pruneSize < minPruneSize {
+		r.pruneSize = minPruneSize
+	}
+}
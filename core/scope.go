@@ -0,0 +1,180 @@
+package core
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Scope rule types
+const (
+	ScopeRuleDomain = "domain"
+	ScopeRuleIP     = "ip"
+	ScopeRuleRegex  = "regex"
+)
+
+// ScopeRule is a single include or exclude rule of the scope
+type ScopeRule struct {
+	Type    string
+	Exclude bool
+	Value   string
+	ipRange *IPRange
+	regex   *regexp.Regexp
+}
+
+// Scope holds include and exclude rules for hosts and URLs. Hosts are
+// checked against regular expressions with the URLs of the scanned ports
+type Scope struct {
+	Rules []*ScopeRule
+	Ports []int
+}
+
+// ParseScope reads scope rules, one per line. Lines starting with # are
+// comments, rules prefixed with ! are exclusions. A rule is a domain with
+// optional wildcard (*.example.com), an IP address, CIDR block or IP range,
+// or a regular expression matched against URLs prefixed with re:
+func ParseScope(r io.Reader) (*Scope, error) {
+	scope := &Scope{}
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		rule := &ScopeRule{}
+		if strings.HasPrefix(line, "!") {
+			rule.Exclude = true
+			line = strings.TrimSpace(line[1:])
+		}
+		rule.Value = line
+		if strings.HasPrefix(line, "re:") {
+			regex, err := regexp.Compile(strings.TrimPrefix(line, "re:"))
+			if err != nil {
+				return nil, fmt.Errorf("invalid regular expression on line %d: %s", lineNumber, err)
+			}
+			rule.Type = ScopeRuleRegex
+			rule.regex = regex
+		} else if ipRange, err := ParseIPRange(line); err == nil {
+			rule.Type = ScopeRuleIP
+			rule.ipRange = ipRange
+		} else if strings.ContainsAny(line, "/: ") {
+			return nil, fmt.Errorf("invalid scope rule on line %d: %s", lineNumber, line)
+		} else {
+			rule.Type = ScopeRuleDomain
+			rule.Value = strings.ToLower(strings.TrimSuffix(line, "."))
+		}
+		scope.Rules = append(scope.Rules, rule)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return scope, nil
+}
+
+// Check returns true if the host or URL is in scope, otherwise false
+// and the rule or reason it is blocked for. A host is included by a
+// regular expression matching any URL of the host on the scanned ports,
+// its URLs are checked again once found. IP rules apply to hosts given as
+// an IP address and to the addresses a hostname resolves to, which are
+// given as addresses.
+func (s *Scope) Check(target string, addresses ...string) (bool, string) {
+	host := target
+	isURL := false
+	if u, err := url.Parse(target); err == nil && u.Scheme != "" && u.Host != "" {
+		host = u.Hostname()
+		isURL = true
+	}
+	host = strings.ToLower(strings.TrimSuffix(strings.Trim(host, "[]"), "."))
+
+	hasIncludes := false
+	included := false
+	for _, rule := range s.Rules {
+		if !rule.Exclude {
+			hasIncludes = true
+		}
+		if rule.Type == ScopeRuleRegex && !isURL {
+			// Excluding some URLs of a host doesn't exclude the host
+			if !rule.Exclude && rule.matchesHostURLs(host, s.Ports) {
+				included = true
+			}
+			continue
+		}
+		if !rule.matches(host, target, addresses) {
+			continue
+		}
+		if rule.Exclude {
+			return false, fmt.Sprintf("excluded by %s", rule.Value)
+		}
+		included = true
+	}
+	if included || !hasIncludes {
+		return true, ""
+	}
+	return false, "not matched by any include rule"
+}
+
+// HasIPRules returns true if any rule is an IP rule, which hostnames are
+// checked against with the addresses they resolve to
+func (s *Scope) HasIPRules() bool {
+	for _, rule := range s.Rules {
+		if rule.Type == ScopeRuleIP {
+			return true
+		}
+	}
+	return false
+}
+
+// ExcludesAddress returns true if the IP address is matched by an exclude rule
+func (s *Scope) ExcludesAddress(address string) bool {
+	for _, rule := range s.Rules {
+		if rule.Exclude && rule.Type == ScopeRuleIP && rule.matches(address, "", nil) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesHostURLs returns true if the regular expression matches an
+// http or https URL of the host on any of the ports
+func (r *ScopeRule) matchesHostURLs(host string, ports []int) bool {
+	if len(ports) == 0 {
+		ports = []int{80, 443}
+	}
+	for _, port := range ports {
+		for _, scheme := range []string{"http", "https"} {
+			if r.regex.MatchString(HostAndPortToURL(host, port, scheme)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r *ScopeRule) matches(host string, target string, addresses []string) bool {
+	switch r.Type {
+	case ScopeRuleRegex:
+		return r.regex.MatchString(target)
+	case ScopeRuleIP:
+		if ip := net.ParseIP(host); ip != nil {
+			return r.ipRange.Contains(ip)
+		}
+		for _, address := range addresses {
+			if ip := net.ParseIP(address); ip != nil && r.ipRange.Contains(ip) {
+				return true
+			}
+		}
+		return false
+	case ScopeRuleDomain:
+		if strings.HasPrefix(r.Value, "*.") {
+			return strings.HasSuffix(host, r.Value[1:])
+		}
+		return host == r.Value
+	}
+	return false
+}
@@ -1,14 +1,18 @@
 package core
 
 import (
+	"context"
 	"crypto/sha1"
 	"encoding/json"
 	"fmt"
 	"io"
+	"net"
+	"net/http"
 	"net/url"
 	"os"
 	"path"
 	"path/filepath"
+	"reflect"
 	"runtime"
 	"strconv"
 	"strings"
@@ -33,18 +37,19 @@ const (
 
 // Stats structure
 type Stats struct {
-	StartedAt            time.Time `json:"startedAt"`
-	FinishedAt           time.Time `json:"finishedAt"`
-	PortOpen             uint32    `json:"portOpen"`
-	PortClosed           uint32    `json:"portClosed"`
-	RequestSuccessful    uint32    `json:"requestSuccessful"`
-	RequestFailed        uint32    `json:"requestFailed"`
-	ResponseCode2xx      uint32    `json:"responseCode2xx"`
-	ResponseCode3xx      uint32    `json:"responseCode3xx"`
-	ResponseCode4xx      uint32    `json:"responseCode4xx"`
-	ResponseCode5xx      uint32    `json:"responseCode5xx"`
-	ScreenshotSuccessful uint32    `json:"screenshotSuccessful"`
-	ScreenshotFailed     uint32    `json:"screenshotFailed"`
+	StartedAt            time.Time            `json:"startedAt"`
+	FinishedAt           time.Time            `json:"finishedAt"`
+	PortOpen             uint32               `json:"portOpen"`
+	PortClosed           uint32               `json:"portClosed"`
+	RequestSuccessful    uint32               `json:"requestSuccessful"`
+	RequestFailed        uint32               `json:"requestFailed"`
+	ResponseCode2xx      uint32               `json:"responseCode2xx"`
+	ResponseCode3xx      uint32               `json:"responseCode3xx"`
+	ResponseCode4xx      uint32               `json:"responseCode4xx"`
+	ResponseCode5xx      uint32               `json:"responseCode5xx"`
+	ScreenshotSuccessful uint32               `json:"screenshotSuccessful"`
+	ScreenshotFailed     uint32               `json:"screenshotFailed"`
+	Pools                map[string]PoolStats `json:"pools,omitempty"`
 }
 
 // Duration returns duration
@@ -113,8 +118,30 @@ type Session struct {
 	PageSimilarityClusters map[string][]string `json:"pageSimilarityClusters"`
 	Ports                  []int               `json:"-"`
 	Technologies           map[string][]string `json:"-"`
+	Targets                map[string]*Target  `json:"-"`
+	HostAddresses          map[string]string   `json:"-"`
+	Exclusions             []*IPRange          `json:"-"`
+	Scope                  *Scope              `json:"-"`
+	Credentials            *Credentials        `json:"-"`
+	Proxies                *Proxies            `json:"-"`
+	Transport              *http.Transport     `json:"-"`
+	OutOfScope             []string            `json:"outOfScope"`
+	Incomplete             bool                `json:"incomplete"`
+	Agents                 []string            `json:"agents"`
+	Checkpoint             *Checkpoint         `json:"checkpoint"`
 	EventBus               EventBus.Bus        `json:"-"`
-	WaitGroup              SizedWaitGroup      `json:"-"`
+	Tracker                *WorkTracker        `json:"-"`
+	Pools                  map[string]*Pool    `json:"-"`
+	portAnnotations        map[string][]Annotation
+	doneHandlers           map[string][]func(args []interface{})
+	RateLimiter            *RateLimiter `json:"-"`
+	ctx                    context.Context
+	cancel                 context.CancelFunc
+	cleanupMutex           sync.Mutex
+	cleanups               []func()
+	addressesMutex         sync.Mutex
+	addresses              map[string][]string
+	outOfScopeSeen         map[string]bool
 }
 
 // Technology name and categories
@@ -127,22 +154,47 @@ type Technology []struct {
 }
 
 // Start initiating all required tasks
-func (s *Session) Start() {
+func (s *Session) Start() error {
 	s.Pages = make(map[string]*Page)
 	s.PageSimilarityClusters = make(map[string][]string)
 	s.Technologies = make(map[string][]string)
+	s.Targets = make(map[string]*Target)
+	s.HostAddresses = make(map[string]string)
+	s.portAnnotations = make(map[string][]Annotation)
 	s.initStats()
+	s.initContext()
 	s.initLogger()
-	s.initPorts()
+	if err := s.initPorts(); err != nil {
+		return err
+	}
+	if err := s.initExclusions(); err != nil {
+		return err
+	}
+	if err := s.initScope(); err != nil {
+		return err
+	}
+	if err := s.initCredentials(); err != nil {
+		return err
+	}
+	if err := s.initProxies(); err != nil {
+		return err
+	}
+	s.initTransport()
 	s.initTechnologies()
 	s.initThreads()
 	s.initEventBus()
-	s.initWaitGroup()
+	s.initPools()
+	s.initRateLimiter()
+	return nil
 }
 
 // End reports time finished
 func (s *Session) End() {
 	s.Stats.FinishedAt = time.Now()
+	s.Stats.Pools = make(map[string]PoolStats)
+	for name, pool := range s.Pools {
+		s.Stats.Pools[name] = pool.Stats()
+	}
 }
 
 // AddPage returns page and nil or nil and err if error occure
@@ -162,16 +214,145 @@ func (s *Session) AddPage(url string) (*Page, error) {
 	return page, nil
 }
 
+// AddTarget keeps the target so its metadata can be attached to the page
+func (s *Session) AddTarget(target Target) {
+	s.Lock()
+	defer s.Unlock()
+	s.Targets[target.String()] = &target
+	if target.Address != "" {
+		s.HostAddresses[strings.ToLower(target.Host)] = target.Address
+	}
+}
+
+// SetTargetHeaders sets the headers of a target added before
+func (s *Session) SetTargetHeaders(key string, headers map[string]string) {
+	s.Lock()
+	defer s.Unlock()
+	if target, ok := s.Targets[key]; ok {
+		target.Headers = headers
+	}
+}
+
+func portKey(host string, port string) string {
+	return net.JoinHostPort(strings.ToLower(host), port)
+}
+
+// AnnotatePort keeps the annotation for pages served on the host and port,
+// pages which already exist are annotated right away
+func (s *Session) AnnotatePort(host string, port int, annotation Annotation) {
+	s.Lock()
+	defer s.Unlock()
+	key := portKey(host, strconv.Itoa(port))
+	s.portAnnotations[key] = append(s.portAnnotations[key], annotation)
+	for _, page := range s.Pages {
+		if portKey(page.ParsedURL().Hostname(), page.Port()) == key {
+			page.Annotate(annotation)
+		}
+	}
+}
+
+// ApplyPortAnnotations annotates the page with everything kept for its host and port
+func (s *Session) ApplyPortAnnotations(page *Page) {
+	s.Lock()
+	annotations := s.portAnnotations[portKey(page.ParsedURL().Hostname(), page.Port())]
+	s.Unlock()
+	for _, annotation := range annotations {
+		page.Annotate(annotation)
+	}
+}
+
+// ConnectAddress returns the IP address to connect to instead of resolving
+// the host when probing virtual hosts, or an empty string
+func (s *Session) ConnectAddress(host string) string {
+	if net.ParseIP(strings.Trim(host, "[]")) != nil {
+		return ""
+	}
+	if *s.Options.VHostIP != "" {
+		return *s.Options.VHostIP
+	}
+	if *s.Options.NmapVHosts {
+		s.Lock()
+		defer s.Unlock()
+		return s.HostAddresses[strings.ToLower(host)]
+	}
+	return ""
+}
+
+// DialContext connects to the address through the proxy for connections
+// which aren't HTTP requests if there is one, otherwise to the first
+// address the host resolves to which isn't excluded
+func (s *Session) DialContext(ctx context.Context, dialer *net.Dialer, network string, addr string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	if s.Proxies.ForDial() != nil {
+		if address := s.ConnectAddress(host); address != "" {
+			addr = net.JoinHostPort(address, port)
+		}
+		return s.Proxies.DialContext(ctx, dialer, network, addr)
+	}
+	return s.dialResolved(ctx, dialer, network, host, port)
+}
+
+// dialResolved connects to the first address of the host which isn't
+// excluded or excluded by the scope
+func (s *Session) dialResolved(ctx context.Context, dialer *net.Dialer, network string, host string, port string) (net.Conn, error) {
+	addresses := s.LookupAddresses(host)
+	if len(addresses) == 0 {
+		return nil, fmt.Errorf("unable to resolve %s", host)
+	}
+	var lastErr error
+	for _, address := range addresses {
+		if s.IsExcluded(address) || (s.Scope != nil && s.Scope.ExcludesAddress(address)) {
+			lastErr = fmt.Errorf("%s resolves to excluded address %s", host, address)
+			continue
+		}
+		conn, err := dialer.DialContext(ctx, network, net.JoinHostPort(address, port))
+		if err == nil {
+			return conn, nil
+		}
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+// GetTarget returns target parsed from input for the URL or host if exists or nil
+func (s *Session) GetTarget(key string) *Target {
+	s.Lock()
+	defer s.Unlock()
+	if target, ok := s.Targets[key]; ok {
+		return target
+	}
+	return nil
+}
+
 // GetPage returns page from Session Pages map if exists or nil
 func (s *Session) GetPage(url string) *Page {
+	s.Lock()
+	defer s.Unlock()
 	if page, ok := s.Pages[url]; ok {
 		return page
 	}
 	return nil
 }
 
+// PageList returns all pages of the session, safe to iterate while agents
+// are still adding pages
+func (s *Session) PageList() []*Page {
+	s.Lock()
+	defer s.Unlock()
+	pages := make([]*Page, 0, len(s.Pages))
+	for _, page := range s.Pages {
+		pages = append(pages, page)
+	}
+	return pages
+}
+
 // GetPageByUUID returns page matching by UUID or nil
 func (s *Session) GetPageByUUID(id string) *Page {
+	s.Lock()
+	defer s.Unlock()
 	for _, page := range s.Pages {
 		if page.UUID == id {
 			return page
@@ -189,7 +370,7 @@ func (s *Session) initStats() {
 	}
 }
 
-func (s *Session) initPorts() {
+func (s *Session) initPorts() error {
 	var ports []int
 	switch *s.Options.Ports {
 	case "small":
@@ -204,15 +385,163 @@ func (s *Session) initPorts() {
 		for _, p := range strings.Split(*s.Options.Ports, ",") {
 			port, err := strconv.Atoi(strings.TrimSpace(p))
 			if err != nil {
-				s.Out.Fatal("Invalid port range given: %s\n", err.Error())
+				return fmt.Errorf("invalid port range given: %s", err)
 			}
 			if port < 1 || port > 65535 {
-				s.Out.Fatal("Invalid port given: %v: %s\n", port, err.Error())
+				return fmt.Errorf("invalid port given: %v", port)
 			}
 			ports = append(ports, port)
 		}
 	}
 	s.Ports = ports
+	return nil
+}
+
+func (s *Session) initExclusions() error {
+	for _, value := range *s.Options.Exclude {
+		for _, e := range strings.Split(value, ",") {
+			if strings.TrimSpace(e) == "" {
+				continue
+			}
+			ipRange, err := ParseIPRange(e)
+			if err != nil {
+				return fmt.Errorf("invalid exclusion given: %s", err)
+			}
+			s.Exclusions = append(s.Exclusions, ipRange)
+		}
+	}
+	return nil
+}
+
+// IsExcluded returns true if host is, or resolves to, an IP address which
+// must not be touched
+func (s *Session) IsExcluded(host string) bool {
+	if len(s.Exclusions) == 0 {
+		return false
+	}
+	for _, address := range s.LookupAddresses(host) {
+		ip := net.ParseIP(address)
+		for _, ipRange := range s.Exclusions {
+			if ip != nil && ipRange.Contains(ip) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// LookupAddresses returns the IP addresses connections to the host go to,
+// which is the host itself if it is an IP address, its virtual host IP
+// address or the addresses it resolves to. Lookups are cached
+func (s *Session) LookupAddresses(host string) []string {
+	host = strings.ToLower(strings.TrimSuffix(strings.Trim(host, "[]"), "."))
+	if net.ParseIP(host) != nil {
+		return []string{host}
+	}
+	if address := s.ConnectAddress(host); address != "" {
+		return []string{address}
+	}
+	s.addressesMutex.Lock()
+	addresses, ok := s.addresses[host]
+	s.addressesMutex.Unlock()
+	if ok {
+		return addresses
+	}
+	ctx, cancel := context.WithTimeout(s.ctx, time.Duration(*s.Options.HTTPTimeout)*time.Millisecond)
+	defer cancel()
+	addresses, err := net.DefaultResolver.LookupHost(ctx, host)
+	if err != nil {
+		s.Out.Debug("Unable to resolve %s: %s\n", host, err)
+	}
+	s.addressesMutex.Lock()
+	defer s.addressesMutex.Unlock()
+	if s.addresses == nil {
+		s.addresses = make(map[string][]string)
+	}
+	s.addresses[host] = addresses
+	return addresses
+}
+
+func (s *Session) initScope() error {
+	if *s.Options.ScopePath == "" {
+		return nil
+	}
+	f, err := os.Open(*s.Options.ScopePath)
+	if err != nil {
+		return fmt.Errorf("unable to open scope file %s: %s", *s.Options.ScopePath, err)
+	}
+	defer f.Close()
+	if s.Scope, err = ParseScope(f); err != nil {
+		return fmt.Errorf("unable to parse scope file %s: %s", *s.Options.ScopePath, err)
+	}
+	s.Scope.Ports = s.Ports
+	return nil
+}
+
+func (s *Session) initCredentials() (err error) {
+	s.Credentials, err = NewCredentials(*s.Options.Headers, *s.Options.CookieFiles, *s.Options.Auth)
+	return err
+}
+
+func (s *Session) initProxies() (err error) {
+	s.Proxies, err = NewProxies(*s.Options.Proxy, *s.Options.HTTPProxy, *s.Options.HTTPSProxy)
+	if err != nil {
+		return err
+	}
+	if s.Proxies.ForDial() != nil && (*s.Options.VHostIP != "" || *s.Options.NmapVHosts) {
+		s.Out.Warn("Proxies resolve hostnames themselves, so requests, screenshots and fingerprints sent through a proxy ignore the virtual host IP addresses\n")
+	}
+	return nil
+}
+
+func (s *Session) initTransport() {
+	s.Transport = NewTransport(s)
+	s.AddCleanup(s.Transport.CloseIdleConnections)
+}
+
+// InScope returns true if the host or URL may be touched, blocked
+// targets are logged and recorded in the session
+func (s *Session) InScope(target string) bool {
+	host := target
+	if u, err := url.Parse(target); err == nil && u.Scheme != "" && u.Host != "" {
+		host = u.Hostname()
+	}
+	if s.IsExcluded(host) {
+		s.blockTarget(target, "excluded address")
+		return false
+	}
+	if s.Scope == nil {
+		return true
+	}
+	var addresses []string
+	if s.Scope.HasIPRules() {
+		addresses = s.LookupAddresses(host)
+	}
+	if ok, reason := s.Scope.Check(target, addresses...); !ok {
+		s.blockTarget(target, reason)
+		return false
+	}
+	return true
+}
+
+func (s *Session) blockTarget(target string, reason string) {
+	s.Lock()
+	if s.outOfScopeSeen == nil {
+		// A resumed session already has blocked targets
+		s.outOfScopeSeen = make(map[string]bool)
+		for _, t := range s.OutOfScope {
+			s.outOfScopeSeen[t] = true
+		}
+	}
+	seen := s.outOfScopeSeen[target]
+	if !seen {
+		s.outOfScopeSeen[target] = true
+		s.OutOfScope = append(s.OutOfScope, target)
+	}
+	s.Unlock()
+	if !seen {
+		s.Out.Warn("%s: blocked, out of scope (%s)\n", target, reason)
+	}
 }
 
 func (s *Session) initLogger() {
@@ -228,18 +557,208 @@ func (s *Session) initThreads() {
 	}
 }
 
+func (s *Session) initContext() {
+	if *s.Options.MaxRuntime > 0 {
+		s.ctx, s.cancel = context.WithTimeout(context.Background(), time.Duration(*s.Options.MaxRuntime)*time.Minute)
+	} else {
+		s.ctx, s.cancel = context.WithCancel(context.Background())
+	}
+}
+
+// Context returns the session context, which is done once the session
+// is canceled or has run for longer than the maximum runtime
+func (s *Session) Context() context.Context {
+	return s.ctx
+}
+
+// Cancel stops all in-flight work and marks the session as incomplete
+func (s *Session) Cancel() {
+	s.Lock()
+	s.Incomplete = true
+	s.Unlock()
+	s.cancel()
+}
+
+// SetIncomplete marks whether results of the session are missing
+func (s *Session) SetIncomplete(incomplete bool) {
+	s.Lock()
+	defer s.Unlock()
+	s.Incomplete = incomplete
+}
+
+// Canceled returns true if the session was canceled or ran out of time
+func (s *Session) Canceled() bool {
+	return s.ctx.Err() != nil
+}
+
 func (s *Session) initEventBus() {
 	s.EventBus = EventBus.New()
+	s.Tracker = NewWorkTracker()
+	s.Checkpoint = NewCheckpoint()
+	s.doneHandlers = make(map[string][]func(args []interface{}))
+}
+
+// Subscribe registers an asynchronous handler for the topic, the
+// work tracker counts the event as done once the handler returns.
+// Events still queued when the session is canceled are dropped,
+// except for SessionEnd which lets agents clean up
+func (s *Session) Subscribe(topic string, fn interface{}) error {
+	handler := reflect.ValueOf(fn)
+	if handler.Kind() != reflect.Func {
+		return fmt.Errorf("%s is not of type reflect.Func", handler.Kind())
+	}
+	tracked := reflect.MakeFunc(handler.Type(), func(args []reflect.Value) []reflect.Value {
+		defer s.Tracker.Done(topic)
+		if s.Canceled() && topic != SessionEnd {
+			results := make([]reflect.Value, handler.Type().NumOut())
+			for i := range results {
+				results[i] = reflect.Zero(handler.Type().Out(i))
+			}
+			return results
+		}
+		results := handler.Call(args)
+		if !s.Canceled() {
+			values := make([]interface{}, len(args))
+			for i, arg := range args {
+				values[i] = arg.Interface()
+			}
+			if s.Checkpoint.Handled(topic, values) {
+				for _, done := range s.doneHandlers[topic] {
+					done(values)
+				}
+			}
+		}
+		return results
+	})
+	if err := s.EventBus.SubscribeAsync(topic, tracked.Interface(), false); err != nil {
+		return err
+	}
+	s.Tracker.Subscribed(topic)
+	s.Checkpoint.Subscribed(topic, handler.Type())
+	return nil
+}
+
+// OnEventDone registers fn to be called with the arguments of every event
+// of the topic once all handlers subscribed to the topic have finished it.
+// It has to be called before the session starts publishing events
+func (s *Session) OnEventDone(topic string, fn func(args []interface{})) {
+	s.doneHandlers[topic] = append(s.doneHandlers[topic], fn)
+}
+
+// AddCleanup registers fn to be called by Close, agents use it to remove
+// temporary files however the session ends
+func (s *Session) AddCleanup(fn func()) {
+	s.cleanupMutex.Lock()
+	defer s.cleanupMutex.Unlock()
+	s.cleanups = append(s.cleanups, fn)
+}
+
+// Close cancels the session context and runs the registered cleanup
+// functions in reverse order. It is safe to call Close more than once
+func (s *Session) Close() {
+	s.cleanupMutex.Lock()
+	cleanups := s.cleanups
+	s.cleanups = nil
+	s.cleanupMutex.Unlock()
+	if s.cancel != nil {
+		s.cancel()
+	}
+	for i := len(cleanups) - 1; i >= 0; i-- {
+		cleanups[i]()
+	}
+}
+
+// Publish publishes the event to the EventBus and tracks it until handled.
+// Events which were already published in this session are skipped
+func (s *Session) Publish(topic string, args ...interface{}) {
+	if !s.Checkpoint.Published(topic, args) {
+		return
+	}
+	s.Tracker.Published(topic)
+	s.EventBus.Publish(topic, args...)
+}
+
+// LoadCheckpoint restores pages, stats and the checkpoint of an interrupted
+// session from its session file
+func (s *Session) LoadCheckpoint(filename string) error {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, s); err != nil {
+		return err
+	}
+	if s.Pages == nil || s.Checkpoint == nil {
+		return fmt.Errorf("%s is not a session file with a checkpoint", filename)
+	}
+	s.Version = Version
+	// The agents of the resumed scan are registered again
+	s.Agents = nil
+	s.Checkpoint.init()
+	return nil
 }
 
-func (s *Session) initWaitGroup() {
-	s.WaitGroup = New(*s.Options.Threads)
+// ReplayCheckpoint publishes again the events which were not done when the
+// checkpoint was written and returns how many there were
+func (s *Session) ReplayCheckpoint() (int, error) {
+	pending, err := s.Checkpoint.Pending()
+	if err != nil {
+		return 0, err
+	}
+	for _, event := range pending {
+		s.Tracker.Published(event.Topic)
+		s.EventBus.Publish(event.Topic, event.Args...)
+	}
+	return len(pending), nil
+}
+
+func (s *Session) initPools() {
+	sizes := map[string]*int{
+		PortScanPool:    s.Options.ScanThreads,
+		HTTPPool:        s.Options.HTTPThreads,
+		ScreenshotPool:  s.Options.ScreenshotThreads,
+		FingerprintPool: s.Options.FingerprintThreads,
+	}
+	s.Pools = make(map[string]*Pool)
+	for name, size := range sizes {
+		if *size <= 0 {
+			size = s.Options.Threads
+		}
+		s.Pools[name] = NewPool(name, *size)
+	}
+}
+
+func (s *Session) initRateLimiter() {
+	s.RateLimiter = NewRateLimiter(*s.Options.Rate, *s.Options.HostRate, time.Duration(*s.Options.Jitter)*time.Millisecond)
+}
+
+// Throttle blocks until the rate limits allow another action against the
+// host, returns an error if the session is canceled while waiting. Hosts
+// are limited by the address connections go to, so virtual hosts on one
+// server share its limit
+func (s *Session) Throttle(host string) error {
+	if s.RateLimiter.LimitsHosts() {
+		if addresses := s.LookupAddresses(host); len(addresses) > 0 {
+			host = addresses[0]
+		}
+	}
+	return s.RateLimiter.Wait(s.ctx, host)
+}
+
+// Pool returns the worker pool with the name
+func (s *Session) Pool(name string) *Pool {
+	return s.Pools[name]
 }
 
 // InitDirectories makes needed directories inside OutPath
-func (s *Session) InitDirectories() {
-	*s.Options.OutDir = path.Join(*s.Options.OutDir, getFolderName())
-	if !checkIfPathExists(*s.Options.OutDir) {
+func (s *Session) InitDirectories() error {
+	if *s.Options.Resume != "" {
+		// A resumed session keeps writing to the directory it was started in
+		*s.Options.OutDir = filepath.Dir(*s.Options.Resume)
+	} else {
+		*s.Options.OutDir = path.Join(*s.Options.OutDir, getFolderName())
+	}
+	if *s.Options.Resume == "" && !checkIfPathExists(*s.Options.OutDir) {
 		for i := 1; i < 10000; i++ {
 			newFolderName := *s.Options.OutDir + "(" + fmt.Sprint(i) + ")"
 			if checkIfPathExists(newFolderName) {
@@ -253,10 +772,11 @@ func (s *Session) InitDirectories() {
 		if _, err := os.Stat(d); os.IsNotExist(err) {
 			err = os.MkdirAll(d, 0755)
 			if err != nil {
-				s.Out.Fatal("Failed to create required directory %s: %s\n", d, err.Error())
+				return fmt.Errorf("failed to create required directory %s: %s", d, err)
 			}
 		}
 	}
+	return nil
 }
 
 // InitTechnologies populates map of technology name and url path
@@ -316,31 +836,39 @@ func (s *Session) ReadFile(p string) ([]byte, error) {
 
 // ToJSON returns session in JSON format
 func (s *Session) ToJSON() string {
+	s.Lock()
+	defer s.Unlock()
 	sessionJSON, _ := json.Marshal(s)
 	return string(sessionJSON)
 }
 
-// SaveToFile saves Session to the file
+// SaveToFile saves Session to the file, the file is replaced at once
+// so an interrupted write never leaves a broken checkpoint behind
 func (s *Session) SaveToFile(filename string) error {
 	path := s.GetFilePath(filename)
-	err := os.WriteFile(path, []byte(s.ToJSON()), 0644)
+	err := os.WriteFile(path+".tmp", []byte(s.ToJSON()), 0644)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return os.Rename(path+".tmp", path)
 }
 
 // NewSession sets options and returns a new session
 func NewSession() (*Session, error) {
-	var err error
+	options, err := ParseOptions()
+	if err != nil {
+		return nil, err
+	}
+	return NewSessionWithOptions(options)
+}
+
+// NewSessionWithOptions validates the options and returns a new session
+func NewSessionWithOptions(options Options) (*Session, error) {
 	var session Session
 
 	session.Version = Version
-
-	if session.Options, err = ParseOptions(); err != nil {
-		return nil, err
-	}
+	session.Options = options
 
 	dest, err := os.Stat(*session.Options.OutDir)
 	if os.IsNotExist(err) {
@@ -362,12 +890,22 @@ func NewSession() (*Session, error) {
 		}
 	}
 
+	if *session.Options.Resume != "" {
+		if _, err := os.Stat(*session.Options.Resume); os.IsNotExist(err) {
+			return nil, fmt.Errorf("resume session path %s does not exist", *session.Options.Resume)
+		}
+	}
+
 	if *session.Options.TemplatePath != "" {
 		if _, err := os.Stat(*session.Options.TemplatePath); os.IsNotExist(err) {
 			return nil, fmt.Errorf("template path %s does not exist", *session.Options.TemplatePath)
 		}
 	}
 
+	if *session.Options.VHostIP != "" && net.ParseIP(*session.Options.VHostIP) == nil {
+		return nil, fmt.Errorf("virtual host IP %s is not a valid IP address", *session.Options.VHostIP)
+	}
+
 	envOutPath := os.Getenv("AQUASILY_OUT_PATH")
 	if *session.Options.OutDir == "." && envOutPath != "" {
 		session.Options.OutDir = &envOutPath
@@ -377,7 +915,9 @@ func NewSession() (*Session, error) {
 	session.Options.OutDir = &outdir
 
 	session.Version = Version
-	session.Start()
+	if err := session.Start(); err != nil {
+		return nil, err
+	}
 
 	return &session, nil
 }
@@ -0,0 +1,90 @@
+package core
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Target structure describes a host or URL parsed from input
+type Target struct {
+	Host     string            `json:"host"`
+	Address  string            `json:"address,omitempty"`
+	Port     int               `json:"port,omitempty"`
+	Scheme   string            `json:"scheme,omitempty"`
+	URL      string            `json:"url,omitempty"`
+	Source   string            `json:"source,omitempty"`
+	Metadata map[string]string `json:"metadata,omitempty"`
+	// Headers may hold credentials, so they are never written to files
+	Headers map[string]string `json:"-"`
+}
+
+// NewHostTarget returns a target for a host which still needs port scanning
+func NewHostTarget(host string, source string) Target {
+	return Target{
+		Host:   host,
+		Source: source,
+	}
+}
+
+// NewURLTarget returns a target for a URL
+func NewURLTarget(rawURL string, source string) Target {
+	t := Target{
+		Host:   rawURL,
+		URL:    rawURL,
+		Source: source,
+	}
+	if u, err := url.Parse(rawURL); err == nil {
+		t.Host = u.Hostname()
+		t.Scheme = u.Scheme
+	}
+	return t
+}
+
+// NewServiceTarget returns a target for a service found on host and port,
+// an empty scheme is guessed from the port number
+func NewServiceTarget(host string, port int, scheme string, source string) Target {
+	t := NewURLTarget(HostAndPortToURL(host, port, scheme), source)
+	t.Host = host
+	t.Port = port
+	return t
+}
+
+// IsURL returns true if target doesn't need port scanning
+func (t Target) IsURL() bool {
+	return t.URL != ""
+}
+
+// String returns the URL of the target or its host
+func (t Target) String() string {
+	if t.IsURL() {
+		return t.URL
+	}
+	return t.Host
+}
+
+// SetMetadata sets metadata value if it is not empty
+func (t *Target) SetMetadata(key string, value string) {
+	if value == "" {
+		return
+	}
+	if t.Metadata == nil {
+		t.Metadata = make(map[string]string)
+	}
+	t.Metadata[key] = value
+}
+
+// Describe returns a human readable summary of the target metadata
+func (t Target) Describe() string {
+	var keys []string
+	for key := range t.Metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var parts []string
+	for _, key := range keys {
+		parts = append(parts, fmt.Sprintf("%s: %s", key, t.Metadata[key]))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,74 @@
+package core
+
+import (
+	"sync"
+)
+
+// WorkTracker counts outstanding events per pipeline stage. Every published
+// event adds work for each handler subscribed to its topic, which is done
+// once the handler returns, so the pipeline is drained when nothing is left.
+type WorkTracker struct {
+	mutex       sync.Mutex
+	drained     *sync.Cond
+	subscribers map[string]int
+	pending     map[string]int
+	total       int
+}
+
+// NewWorkTracker returns a new WorkTracker
+func NewWorkTracker() *WorkTracker {
+	t := &WorkTracker{
+		subscribers: make(map[string]int),
+		pending:     make(map[string]int),
+	}
+	t.drained = sync.NewCond(&t.mutex)
+	return t
+}
+
+// Subscribed registers a handler for the stage
+func (t *WorkTracker) Subscribed(stage string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.subscribers[stage]++
+}
+
+// Published adds outstanding work for every handler of the stage
+func (t *WorkTracker) Published(stage string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.pending[stage] += t.subscribers[stage]
+	t.total += t.subscribers[stage]
+}
+
+// Done marks work of a single handler of the stage as finished
+func (t *WorkTracker) Done(stage string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.pending[stage]--
+	t.total--
+	if t.total == 0 {
+		t.drained.Broadcast()
+	}
+}
+
+// Pending returns number of outstanding events per stage
+func (t *WorkTracker) Pending() map[string]int {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	pending := make(map[string]int)
+	for stage, n := range t.pending {
+		if n > 0 {
+			pending[stage] = n
+		}
+	}
+	return pending
+}
+
+// Wait blocks until there is no outstanding work left
+func (t *WorkTracker) Wait() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	for t.total > 0 {
+		t.drained.Wait()
+	}
+}
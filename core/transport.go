@@ -0,0 +1,45 @@
+package core
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// NewTransport returns the HTTP transport shared by all requests of the
+// session. It keeps connections alive for reuse, speaks HTTP/2 where the
+// server supports it, ignores certificate errors, sends requests through
+// the proxies of the session and connects to the virtual host IP address
+// of hosts which have one, never to excluded addresses
+func NewTransport(s *Session) *http.Transport {
+	timeout := time.Duration(*s.Options.HTTPTimeout) * time.Millisecond
+	dialer := &net.Dialer{Timeout: timeout, KeepAlive: 30 * time.Second}
+	proxies := make(map[string]bool)
+	for _, u := range []*url.URL{s.Proxies.All, s.Proxies.HTTP, s.Proxies.HTTPS} {
+		if u != nil {
+			proxies[strings.ToLower(u.Host)] = true
+		}
+	}
+	return &http.Transport{
+		Proxy: s.Proxies.ForRequest,
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			host, port, err := net.SplitHostPort(addr)
+			if err != nil || proxies[strings.ToLower(addr)] {
+				// Connections to a proxy are left alone, the proxy resolves the host
+				return dialer.DialContext(ctx, network, addr)
+			}
+			return s.dialResolved(ctx, dialer, network, host, port)
+		},
+		TLSClientConfig:       &tls.Config{InsecureSkipVerify: true},
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   10,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   timeout,
+		ExpectContinueTimeout: time.Second,
+	}
+}
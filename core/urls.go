@@ -2,6 +2,7 @@ package core
 
 import (
 	"fmt"
+	"strings"
 )
 
 var (
@@ -12,6 +13,9 @@ var (
 // HostAndPortToURL returns a URL from host and port
 func HostAndPortToURL(host string, port int, protocol string) string {
 	var url string
+	if strings.Contains(host, ":") && !strings.HasPrefix(host, "[") {
+		host = fmt.Sprintf("[%s]", host)
+	}
 	if protocol != "" {
 		url = fmt.Sprintf("%s://%s", protocol, host)
 	} else if isSecurePort(port) {
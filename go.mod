@@ -4,6 +4,7 @@ go 1.19
 
 require (
 	github.com/asaskevich/EventBus v0.0.0-20200907212545-49d423059eef
+	github.com/chromedp/cdproto v0.0.0-20220914223734-4ab9dc957c3e
 	github.com/chromedp/chromedp v0.8.5
 	github.com/fatih/color v1.13.0
 	github.com/google/uuid v1.3.0
@@ -12,10 +13,10 @@ require (
 	github.com/pmezard/go-difflib v1.0.0
 	github.com/projectdiscovery/wappalyzergo v0.0.60
 	golang.org/x/net v0.0.0-20220919232410-f2f64ebce3c1
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
-	github.com/chromedp/cdproto v0.0.0-20220914223734-4ab9dc957c3e // indirect
 	github.com/chromedp/sysutil v1.0.0 // indirect
 	github.com/gobwas/httphead v0.1.0 // indirect
 	github.com/gobwas/pool v0.2.1 // indirect
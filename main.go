@@ -2,152 +2,170 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
 	"fmt"
-	"net/url"
+	"io"
 	"os"
+	"os/signal"
+	"path/filepath"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/VasilyKaiser/aquasily/agents"
 	"github.com/VasilyKaiser/aquasily/core"
 	"github.com/VasilyKaiser/aquasily/parsers"
-	"github.com/google/uuid"
+	"github.com/VasilyKaiser/aquasily/runner"
 )
 
 var (
 	sess        *core.Session
+	run         *runner.Runner
 	err         error
-	reportHTML  = "aquasily_report.html"
-	urlsTXT     = "aquasily_urls.txt"
-	sessionJSON = "aquasily_session.json"
-	targets     []string
+	streamInput bool
 )
 
-func isURL(s string) bool {
-	u, err := url.ParseRequestURI(s)
-	if err != nil {
-		return false
-	}
-	if u.Scheme == "" {
-		return false
-	}
-	return true
-}
+func parseInput() {
+	sources := 0
 
-func hasSupportedScheme(s string) bool {
-	u, err := url.ParseRequestURI(s)
-	if err != nil {
-		return false
+	if *sess.Options.Resume != "" {
+		// Targets of the resumed session were added by runner.Resume
+		sources++
 	}
-	if u.Scheme == "http" || u.Scheme == "https" {
-		return true
-	}
-	return false
-}
 
-func generateReport() {
-	sess.Out.Important("Generating HTML report...")
-	var template []byte
-	if *sess.Options.TemplatePath != "" {
-		template, err = os.ReadFile(*sess.Options.TemplatePath)
-	} else {
-		template, err = core.Asset("static/report_template.html")
+	readStdin := false
+	for _, pattern := range *sess.Options.Inputs {
+		// "-" explicitly requests reading from stdin alongside other inputs
+		if pattern == "-" {
+			readStdin = true
+			continue
+		}
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			fatal("Invalid input file pattern %s: %s\n", pattern, err)
+		}
+		if len(matches) == 0 {
+			fatal("No input files found matching %s\n", pattern)
+		}
+		for _, match := range matches {
+			f, err := os.Open(match)
+			if err != nil {
+				fatal("Unable to open input file %s: %s\n", match, err)
+			}
+			sess.Out.Debug("Reading input file %s\n", match)
+			sources++
+			parseSource(match, f)
+			f.Close()
+		}
 	}
 
-	if err != nil {
-		sess.Out.Fatal("Can't read report template file\n")
-	}
-	report := core.NewReport(sess, string(template))
-	f, err := os.OpenFile(sess.GetFilePath(reportHTML), os.O_RDWR|os.O_CREATE, 0644)
-	if err != nil {
-		sess.Out.Fatal("Error during report generation: %s\n", err)
-	}
-	err = report.Render(f)
-	if err != nil {
-		sess.Out.Fatal("Error during report generation: %s\n", err)
+	if len(sess.Options.Targets) > 0 {
+		sources++
+		parseSource("arguments", strings.NewReader(strings.Join(sess.Options.Targets, "\n")))
 	}
-	sess.Out.Important(" done\n\n")
-}
 
-func parseStdin() {
+	// Stdin is only read implicitly when no other input was given, so a
+	// dangling pipe from a wrapper can't block reading files or arguments
 	stat, _ := os.Stdin.Stat()
-	if (stat.Mode() & os.ModeCharDevice) == 0 {
-		sess.Out.Debug("Data is piped to stdin\n")
-		// Reading piped input
-		reader := bufio.NewReader(os.Stdin)
-		if *sess.Options.Nmap {
-			parser := parsers.NewNmapParser()
-			targets, err = parser.Parse(reader)
-			if err != nil {
-				sess.Out.Fatal("Unable to parse input as Nmap/Masscan XML: %s\n", err)
-			}
+	if readStdin || (sources == 0 && (stat.Mode()&os.ModeCharDevice) == 0 && *sess.Options.Resume == "") {
+		sources++
+		if *sess.Options.Stream {
+			// Stdin is read once the pipeline is running, see streamStdin
+			streamInput = true
 		} else {
-			parser := parsers.NewRegexParser()
-			targets, err = parser.Parse(reader)
-			if err != nil {
-				sess.Out.Fatal("Unable to parse input.\n")
-			}
+			sess.Out.Debug("Reading data from stdin\n")
+			parseSource("stdin", os.Stdin)
 		}
-	} else {
-		sess.Out.Fatal("Feed me with hosts/urls using pipe!\n")
+	}
+
+	if sources == 0 {
+		fatal("Feed me with hosts/urls using pipe, -input files or arguments!\n")
+	}
+	if excluded := run.Excluded(); excluded > 0 {
+		sess.Out.Warn("Skipped %d out of scope targets\n", excluded)
 	}
 }
 
-func calculatePagesStructure() {
-	sess.Out.Important("\nCalculating page structures...")
-	f, _ := os.OpenFile(sess.GetFilePath(urlsTXT), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
-	for _, page := range sess.Pages {
-		filename := sess.GetFilePath(fmt.Sprintf("html/%s.html", page.BaseFilename()))
-		body, err := os.Open(filename)
+// streamStdin publishes targets from every line of stdin as soon as it is read
+func streamStdin() {
+	format := *sess.Options.InputFormat
+	if format == "auto" {
+		format = parsers.DefaultFormat
+	}
+	if format != parsers.DefaultFormat && format != "masscan-list" && format != "masscan-json" {
+		fatal("Streaming input supports only line based formats: %s, masscan-list, masscan-json\n", parsers.DefaultFormat)
+	}
+	parser, err := parsers.Get(format, run.ParserOptions())
+	if err != nil {
+		fatal("%s\n", err)
+	}
+	sess.Out.Debug("Streaming %s data from stdin\n", format)
+	streamed := 0
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() && !sess.Canceled() {
+		found, err := parser.Parse(strings.NewReader(scanner.Text()))
 		if err != nil {
+			sess.Out.Error("Unable to parse input line %q: %s\n", scanner.Text(), err)
 			continue
 		}
-		structure, _ := core.GetPageStructure(body)
-		page.PageStructure = structure
-		f.WriteString(page.URL + "\n")
+		streamed += run.AddTargets(found)
+	}
+	if err := scanner.Err(); err != nil {
+		sess.Out.Error("Error reading stdin: %s\n", err)
 	}
-	f.Close()
-	sess.Out.Important(" done\n")
+	sess.Out.Important("Input finished, %d targets streamed from stdin\n", streamed)
 }
 
-func clusterSimilarPages() {
-	sess.Out.Important("Clustering similar pages...")
-	for _, page := range sess.Pages {
-		foundCluster := false
-		for clusterUUID, cluster := range sess.PageSimilarityClusters {
-			addToCluster := true
-			for _, pageURL := range cluster {
-				page2 := sess.GetPage(pageURL)
-				if page2 != nil && core.GetSimilarity(page.PageStructure, page2.PageStructure) < 0.80 {
-					addToCluster = false
-					break
-				}
-			}
+// fatal logs the message, removes temporary files and exits with code 1
+func fatal(format string, args ...interface{}) {
+	sess.Out.Fatal(format, args...)
+	sess.Close()
+	os.Exit(1)
+}
 
-			if addToCluster {
-				foundCluster = true
-				sess.PageSimilarityClusters[clusterUUID] = append(sess.PageSimilarityClusters[clusterUUID], page.URL)
-				break
-			}
-		}
+// watchRuntime reports when the scan is stopped by the maximum runtime
+func watchRuntime() {
+	<-sess.Context().Done()
+	if sess.Context().Err() == context.DeadlineExceeded {
+		sess.Out.Warn("\nMaximum runtime of %d minutes reached, writing partial report\n", *sess.Options.MaxRuntime)
+	}
+}
 
-		if !foundCluster {
-			newClusterUUID := uuid.New().String()
-			sess.PageSimilarityClusters[newClusterUUID] = []string{page.URL}
-		}
+// handleInterrupt cancels the session on the first Ctrl-C so a partial
+// report can be written, and quits immediately on the second one
+func handleInterrupt() {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-signals
+		sess.Out.Warn("\nInterrupted, writing partial report (press Ctrl-C again to quit immediately)\n")
+		sess.Cancel()
+		<-signals
+		sess.Close()
+		os.Exit(1)
+	}()
+}
+
+// parseSource adds the targets found in the input to the scan
+func parseSource(name string, r io.Reader) {
+	format := *sess.Options.InputFormat
+	if *sess.Options.Nmap {
+		format = "nmap"
+	}
+	if _, err := run.AddInput(name, r, format); err != nil {
+		fatal("%s\n", err)
 	}
-	sess.Out.Important(" done\n")
 }
 
 func reportFromSessionFile() {
 	jsonSession, err := os.ReadFile(*sess.Options.SessionPath)
 	if err != nil {
-		sess.Out.Fatal("Unable to read session file at %s: %s\n", *sess.Options.SessionPath, err)
+		fatal("Unable to read session file at %s: %s\n", *sess.Options.SessionPath, err)
 	}
 	var parsedSession core.Session
 	if err := json.Unmarshal(jsonSession, &parsedSession); err != nil {
-		sess.Out.Fatal("Unable to parse session file at %s: %s\n", *sess.Options.SessionPath, err)
+		fatal("Unable to parse session file at %s: %s\n", *sess.Options.SessionPath, err)
 	}
 
 	sess.Out.Important("Loaded Aquasily session at %s\n", *sess.Options.SessionPath)
@@ -160,20 +178,23 @@ func reportFromSessionFile() {
 		template, err = core.Asset("static/report_template.html")
 	}
 	if err != nil {
-		sess.Out.Fatal("Can't read report template file: %s\n", err.Error())
+		fatal("Can't read report template file: %s\n", err.Error())
 	}
 
 	report := core.NewReport(&parsedSession, string(template))
-	f, err := os.OpenFile(sess.GetFilePath(reportHTML), os.O_RDWR|os.O_CREATE, 0644)
+	f, err := os.OpenFile(sess.GetFilePath(runner.ReportHTML), os.O_RDWR|os.O_CREATE, 0644)
 	if err != nil {
-		sess.Out.Fatal("Error during report generation: %s\n", err)
+		fatal("Error during report generation: %s\n", err)
 	}
 	err = report.Render(f)
 	if err != nil {
-		sess.Out.Fatal("Error during report generation: %s\n", err)
+		fatal("Error during report generation: %s\n", err)
 	}
 	sess.Out.Important(" done\n\n")
-	sess.Out.Important("Wrote HTML report to: %s\n\n", sess.GetFilePath(reportHTML))
+	if parsedSession.Incomplete {
+		sess.Out.Warn("Session is incomplete, the scan was interrupted\n")
+	}
+	sess.Out.Important("Wrote HTML report to: %s\n\n", sess.GetFilePath(runner.ReportHTML))
 }
 
 func main() {
@@ -181,6 +202,7 @@ func main() {
 		fmt.Printf("Couldn't start a new session: %s\n", err.Error())
 		os.Exit(1)
 	}
+	run = runner.NewWithSession(sess)
 
 	if *sess.Options.Version {
 		sess.Out.Warn("%s", core.Name)
@@ -194,62 +216,75 @@ func main() {
 		os.Exit(0)
 	}
 
-	parseStdin()
-	sess.InitDirectories()
+	if *sess.Options.Resume != "" {
+		if err := run.Resume(*sess.Options.Resume); err != nil {
+			fatal("Unable to resume session from %s: %s\n", *sess.Options.Resume, err)
+		}
+		sess.Out.Important("Resuming session from %s, %d events unfinished\n", *sess.Options.Resume, sess.Checkpoint.Unfinished())
+	}
+
+	parseInput()
 
-	agents.NewTCPPortScanner().Register(sess)
-	agents.NewURLPublisher().Register(sess)
-	agents.NewURLRequester().Register(sess)
-	agents.NewURLHostnameResolver().Register(sess)
-	agents.NewURLPageTitleExtractor().Register(sess)
-	agents.NewURLScreenshotter().Register(sess)
-	agents.NewURLTechnologyFingerprinter().Register(sess)
+	if err := run.RegisterAgents(); err != nil {
+		fatal("%s\n", err)
+	}
 
-	if len(targets) == 0 {
-		sess.Out.Fatal("No targets found in input.\n")
+	targets := run.Targets()
+	// A resumed session may only have unfinished work left on targets it reached
+	if len(targets) == 0 && !streamInput && sess.Checkpoint.Unfinished() == 0 {
+		fatal("No targets found in input.\n")
+	}
+	if err := run.Prepare(); err != nil {
+		fatal("%s\n", err)
 	}
 
 	sess.Out.Important("===================================\n")
-	sess.Out.Important("Targets    : %d\n", len(targets))
-	sess.Out.Important("Threads    : %d\n", *sess.Options.Threads)
+	if streamInput {
+		sess.Out.Important("Targets    : %d + streaming from stdin\n", len(targets))
+	} else {
+		sess.Out.Important("Targets    : %d\n", len(targets))
+	}
+	var pools []string
+	for _, name := range core.PoolNames {
+		pools = append(pools, fmt.Sprintf("%s %d", name, sess.Pool(name).Size))
+	}
+	sess.Out.Important("Threads    : %s\n", strings.Join(pools, ", "))
+	if len(sess.Agents) < len(agents.Names()) {
+		sess.Out.Important("Agents     : %s\n", strings.Replace(strings.Join(sess.Agents, ", "), "agent:", "", -1))
+	}
 	sess.Out.Important("Ports      : %s\n", strings.Trim(strings.Replace(fmt.Sprint(sess.Ports), " ", ", ", -1), "[]"))
+	if *sess.Options.MaxRuntime > 0 {
+		sess.Out.Important("Max runtime: %d minutes\n", *sess.Options.MaxRuntime)
+	}
+	if sess.RateLimiter.Enabled() {
+		sess.Out.Important("Rate limit : %g/s total, %g/s per host, %dms jitter\n", *sess.Options.Rate, *sess.Options.HostRate, *sess.Options.Jitter)
+	}
 	sess.Out.Important("Output dir : %s\n", *sess.Options.OutDir)
 	sess.Out.Important("===================================\n\n")
 
-	sess.EventBus.Publish(core.SessionStart)
+	handleInterrupt()
+	go watchRuntime()
 
-	for _, target := range targets {
-		if isURL(target) {
-			if hasSupportedScheme(target) {
-				sess.EventBus.Publish(core.URL, target)
-			}
-		} else {
-			sess.EventBus.Publish(core.Host, target)
+	if err := run.Start(); err != nil {
+		fatal("%s\n", err)
+	}
+	if streamInput {
+		inputDone := make(chan struct{})
+		go func() {
+			streamStdin()
+			close(inputDone)
+		}()
+		select {
+		case <-inputDone:
+		case <-sess.Context().Done():
 		}
 	}
 
-	time.Sleep(2 * time.Second)
-	sess.EventBus.WaitAsync()
-	sess.WaitGroup.Wait()
-
-	sess.EventBus.Publish(core.SessionEnd)
-	time.Sleep(1 * time.Second)
-	sess.EventBus.WaitAsync()
-	sess.WaitGroup.Wait()
-
-	calculatePagesStructure()
-
-	clusterSimilarPages()
-
-	generateReport()
-
-	sess.End()
-
-	sess.Out.Important("Writing session file...\n")
-	err = sess.SaveToFile(sessionJSON)
-	if err != nil {
-		sess.Out.Error("Failed!\n")
-		sess.Out.Debug("Error: %v\n", err)
+	if _, err := run.Wait(); err != nil {
+		sess.Out.Error("%s\n", err)
+	}
+	if err := run.WriteReport(); err != nil {
+		fatal("%s\n", err)
 	}
 
 	sess.Out.Important("==============================\n")
@@ -274,5 +309,15 @@ func main() {
 	sess.Out.Info(" - Failed     : %v\n", sess.Stats.ScreenshotFailed)
 	sess.Out.Important("==============================\n")
 
-	sess.Out.Important("Wrote HTML report to: %s\n\n", sess.GetFilePath(reportHTML))
+	sess.Out.Important("Pools:\n")
+	for _, name := range core.PoolNames {
+		stats := sess.Stats.Pools[name]
+		sess.Out.Info(" - %-11s : %d tasks, peak %d/%d, %.0f%% utilised\n", name, stats.Tasks, stats.Peak, stats.Size, stats.Utilisation*100)
+	}
+	sess.Out.Important("==============================\n")
+
+	if sess.Incomplete {
+		sess.Out.Warn("Session is incomplete, the scan was interrupted\n")
+	}
+	sess.Out.Important("Wrote HTML report to: %s\n\n", sess.GetFilePath(runner.ReportHTML))
 }
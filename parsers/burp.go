@@ -0,0 +1,72 @@
+package parsers
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+
+	"github.com/VasilyKaiser/aquasily/core"
+)
+
+type burpExport struct {
+	Items []struct {
+		URL  string `xml:"url"`
+		Host struct {
+			IP string `xml:"ip,attr"`
+		} `xml:"host"`
+		Status   string `xml:"status"`
+		MimeType string `xml:"mimetype"`
+	} `xml:"item"`
+	Issues []struct {
+		Host string `xml:"host"`
+		Path string `xml:"path"`
+	} `xml:"issue"`
+}
+
+// BurpParser structure
+type BurpParser struct {
+	options Options
+}
+
+// NewBurpParser returns BurpParser structure
+func NewBurpParser(options Options) *BurpParser {
+	return &BurpParser{options: options}
+}
+
+// Parse returns parsed targets from Burp Suite "Save items" or issues XML export
+func (p *BurpParser) Parse(r io.Reader) ([]core.Target, error) {
+	var targets []core.Target
+	targetsFilter := make(map[string]struct{})
+
+	var export burpExport
+	decoder := xml.NewDecoder(r)
+	// Burp exports declare an inline DTD which the decoder doesn't need
+	decoder.Strict = false
+	if err := decoder.Decode(&export); err != nil {
+		return targets, err
+	}
+
+	add := func(target core.Target) {
+		if _, found := targetsFilter[target.URL]; found {
+			return
+		}
+		targets = append(targets, target)
+		targetsFilter[target.URL] = struct{}{}
+	}
+	for _, item := range export.Items {
+		target, ok := webTarget(strings.TrimSpace(item.URL), "burp", p.options.DeepPaths)
+		if !ok {
+			continue
+		}
+		target.SetMetadata("address", item.Host.IP)
+		add(target)
+	}
+	for _, issue := range export.Issues {
+		target, ok := webTarget(strings.TrimSpace(issue.Host)+strings.TrimSpace(issue.Path), "burp", p.options.DeepPaths)
+		if !ok {
+			continue
+		}
+		add(target)
+	}
+	return targets, nil
+}
@@ -0,0 +1,121 @@
+package parsers
+
+import (
+	"encoding/json"
+	"io"
+	"net/textproto"
+	"net/url"
+	"strings"
+
+	"github.com/VasilyKaiser/aquasily/core"
+)
+
+// Request headers which are not replayed, they are either set by the
+// HTTP client itself or would turn the response into a cache validation
+var harSkippedHeaders = map[string]struct{}{
+	"host":              {},
+	"content-length":    {},
+	"connection":        {},
+	"accept-encoding":   {},
+	"if-none-match":     {},
+	"if-modified-since": {},
+	"upgrade":           {},
+	"te":                {},
+}
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type harFile struct {
+	Log struct {
+		Entries []struct {
+			ResourceType string `json:"_resourceType"`
+			Request      struct {
+				Method  string         `json:"method"`
+				URL     string         `json:"url"`
+				Headers []harNameValue `json:"headers"`
+				Cookies []harNameValue `json:"cookies"`
+			} `json:"request"`
+			Response struct {
+				Status  int `json:"status"`
+				Content struct {
+					MimeType string `json:"mimeType"`
+				} `json:"content"`
+			} `json:"response"`
+		} `json:"entries"`
+	} `json:"log"`
+}
+
+// HARParser structure
+type HARParser struct{}
+
+// NewHARParser returns HARParser structure
+func NewHARParser() *HARParser {
+	return &HARParser{}
+}
+
+// Parse returns a target with recorded request headers and cookies
+// for every unique document URL in a HAR capture
+func (p *HARParser) Parse(r io.Reader) ([]core.Target, error) {
+	var targets []core.Target
+	targetsFilter := make(map[string]struct{})
+
+	var har harFile
+	if err := json.NewDecoder(r).Decode(&har); err != nil {
+		return targets, err
+	}
+	for _, entry := range har.Log.Entries {
+		if entry.Request.Method != "GET" {
+			continue
+		}
+		if entry.ResourceType != "" && entry.ResourceType != "document" {
+			continue
+		}
+		if entry.ResourceType == "" && !strings.HasPrefix(entry.Response.Content.MimeType, "text/html") {
+			continue
+		}
+		u, err := url.Parse(entry.Request.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			continue
+		}
+		u.Fragment = ""
+		target := core.NewURLTarget(u.String(), "har")
+		if _, found := targetsFilter[target.URL]; found {
+			continue
+		}
+
+		target.Headers = make(map[string]string)
+		for _, header := range entry.Request.Headers {
+			if strings.HasPrefix(header.Name, ":") {
+				continue
+			}
+			if _, skip := harSkippedHeaders[strings.ToLower(header.Name)]; skip {
+				continue
+			}
+			// HTTP/2 captures use lowercase names and may split cookies over
+			// several headers, which are joined like HTTP/1.1 sends them
+			name := textproto.CanonicalMIMEHeaderKey(header.Name)
+			if value, found := target.Headers[name]; found {
+				separator := ", "
+				if name == "Cookie" {
+					separator = "; "
+				}
+				target.Headers[name] = value + separator + header.Value
+				continue
+			}
+			target.Headers[name] = header.Value
+		}
+		if _, found := target.Headers["Cookie"]; !found && len(entry.Request.Cookies) > 0 {
+			var cookies []string
+			for _, cookie := range entry.Request.Cookies {
+				cookies = append(cookies, cookie.Name+"="+cookie.Value)
+			}
+			target.Headers["Cookie"] = strings.Join(cookies, "; ")
+		}
+		targets = append(targets, target)
+		targetsFilter[target.URL] = struct{}{}
+	}
+	return targets, nil
+}
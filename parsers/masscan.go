@@ -0,0 +1,130 @@
+package parsers
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/VasilyKaiser/aquasily/core"
+)
+
+type masscanRecord struct {
+	IP    string `json:"ip"`
+	Ports []struct {
+		Port    int    `json:"port"`
+		Proto   string `json:"proto"`
+		Status  string `json:"status"`
+		Service struct {
+			Name string `json:"name"`
+		} `json:"service"`
+	} `json:"ports"`
+}
+
+// MasscanJSONParser structure
+type MasscanJSONParser struct{}
+
+// NewMasscanJSONParser returns MasscanJSONParser structure
+func NewMasscanJSONParser() *MasscanJSONParser {
+	return &MasscanJSONParser{}
+}
+
+// Parse returns parsed targets from masscan -oJ or -oD input
+func (p *MasscanJSONParser) Parse(r io.Reader) ([]core.Target, error) {
+	var targets []core.Target
+	targetsFilter := make(map[string]struct{})
+
+	// Masscan writes one record per line, wrapped into a JSON array with
+	// trailing commas which can't be decoded as a whole
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSuffix(strings.TrimSpace(scanner.Text()), ",")
+		if line == "" || line == "[" || line == "]" || line == "{ }" || line == "{}" {
+			continue
+		}
+		var record masscanRecord
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			return targets, fmt.Errorf("invalid masscan JSON record: %s", err)
+		}
+		if record.IP == "" {
+			continue
+		}
+		for _, port := range record.Ports {
+			if port.Proto != "tcp" || (port.Status != "" && port.Status != "open") {
+				continue
+			}
+			protocol, ok := serviceProtocol(port.Port, port.Service.Name, "")
+			if !ok {
+				continue
+			}
+			target := core.NewServiceTarget(record.IP, port.Port, protocol, "masscan")
+			if _, found := targetsFilter[target.URL]; found {
+				continue
+			}
+			target.SetMetadata("service", port.Service.Name)
+			targets = append(targets, target)
+			targetsFilter[target.URL] = struct{}{}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return targets, err
+	}
+	return targets, nil
+}
+
+// MasscanListParser structure
+type MasscanListParser struct{}
+
+// NewMasscanListParser returns MasscanListParser structure
+func NewMasscanListParser() *MasscanListParser {
+	return &MasscanListParser{}
+}
+
+// Parse returns parsed targets from masscan -oL input
+func (p *MasscanListParser) Parse(r io.Reader) ([]core.Target, error) {
+	var targets []core.Target
+	targetsFilter := make(map[string]struct{})
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		// <state> <proto> <port> <ip> <timestamp>, banner lines
+		// additionally carry the service name and banner text
+		fields := strings.Fields(line)
+		if len(fields) < 4 {
+			return targets, fmt.Errorf("invalid masscan list line: %s", line)
+		}
+		if fields[1] != "tcp" || (fields[0] != "open" && fields[0] != "banner") {
+			continue
+		}
+		port, err := strconv.Atoi(fields[2])
+		if err != nil {
+			return targets, fmt.Errorf("invalid port in masscan list line: %s", line)
+		}
+		var service string
+		if fields[0] == "banner" && len(fields) > 5 {
+			service = fields[5]
+		}
+		protocol, ok := serviceProtocol(port, service, "")
+		if !ok {
+			continue
+		}
+		target := core.NewServiceTarget(fields[3], port, protocol, "masscan")
+		if _, found := targetsFilter[target.URL]; found {
+			continue
+		}
+		target.SetMetadata("service", service)
+		targets = append(targets, target)
+		targetsFilter[target.URL] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return targets, err
+	}
+	return targets, nil
+}
@@ -1,7 +1,10 @@
 package parsers
 
 import (
+	"bytes"
+	"encoding/xml"
 	"io"
+	"strings"
 
 	"github.com/VasilyKaiser/aquasily/core"
 
@@ -16,9 +19,24 @@ func NewNmapParser() *NmapParser {
 	return &NmapParser{}
 }
 
+// IsNmapXML reports whether data looks like Nmap/Masscan XML output,
+// which has a nmaprun root element with or without an XML declaration
+func IsNmapXML(data []byte) bool {
+	decoder := xml.NewDecoder(bytes.NewReader(head(data)))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return false
+		}
+		if element, ok := token.(xml.StartElement); ok {
+			return element.Name.Local == "nmaprun"
+		}
+	}
+}
+
 // Parse returns parsed targets from input
-func (p *NmapParser) Parse(r io.Reader) ([]string, error) {
-	var targets []string
+func (p *NmapParser) Parse(r io.Reader) ([]core.Target, error) {
+	var targets []core.Target
 	bytes, err := io.ReadAll(r)
 	if err != nil {
 		return targets, err
@@ -28,52 +46,72 @@ func (p *NmapParser) Parse(r io.Reader) ([]string, error) {
 		return targets, err
 	}
 	for _, host := range scan.Hosts {
-		urls := p.hostToURLs(host)
-		targets = append(targets, urls...)
-
+		targets = append(targets, p.hostToTargets(host)...)
 	}
 	return targets, nil
 }
 
-func (p *NmapParser) isHTTPPort(port int) bool {
-	for _, p := range core.XLargePortList {
-		if p == port {
-			return true
+func (p *NmapParser) hostToTargets(host nmap.Host) []core.Target {
+	var targets []core.Target
+	var hostnames, addresses []string
+	for _, hostname := range host.Hostnames {
+		hostnames = append(hostnames, hostname.Name)
+	}
+	for _, address := range host.Addresses {
+		if address.AddrType == "mac" {
+			continue
 		}
+		addresses = append(addresses, address.Addr)
 	}
-	return false
-}
-
-func (p *NmapParser) hostToURLs(host nmap.Host) []string {
-	var urls []string
 	for _, port := range host.Ports {
 		if port.State.State != "open" {
 			continue
 		}
-		var protocol string
-		if port.Service.Name == "ssl" {
-			protocol = "https"
-		} else if port.Service.Tunnel == "ssl" && (port.Service.Name != "smtp" && port.Service.Name != "imap" && port.Service.Name != "pop3") {
-			protocol = "https"
-		} else if port.Service.Name == "http" || port.Service.Name == "http-alt" {
-			protocol = "http"
-		} else {
-			if !p.isHTTPPort(port.PortId) {
-				continue
-			}
+		protocol, ok := serviceProtocol(port.PortId, port.Service.Name, port.Service.Tunnel)
+		if !ok {
+			continue
 		}
-		if len(host.Hostnames) > 0 {
-			for _, hostname := range host.Hostnames {
-				urls = append(urls, core.HostAndPortToURL(hostname.Name, port.PortId, protocol))
-			}
-		} else {
-			for _, address := range host.Addresses {
-				if address.AddrType == "mac" {
-					continue
-				}
-				urls = append(urls, core.HostAndPortToURL(address.Addr, port.PortId, protocol))
+		names := hostnames
+		if len(names) == 0 {
+			names = addresses
+		}
+		for _, name := range names {
+			target := core.NewServiceTarget(name, port.PortId, protocol, "nmap")
+			if len(hostnames) > 0 && len(addresses) > 0 {
+				target.Address = addresses[0]
 			}
+			target.SetMetadata("service", port.Service.Name)
+			target.SetMetadata("tunnel", port.Service.Tunnel)
+			target.SetMetadata("product", port.Service.Product)
+			target.SetMetadata("version", port.Service.Version)
+			target.SetMetadata("extrainfo", port.Service.ExtraInfo)
+			target.SetMetadata("hostnames", strings.Join(hostnames, ","))
+			target.SetMetadata("addresses", strings.Join(addresses, ","))
+			targets = append(targets, target)
+		}
+	}
+	return targets
+}
+
+func isHTTPPort(port int) bool {
+	for _, p := range core.XLargePortList {
+		if p == port {
+			return true
 		}
 	}
-	return urls
+	return false
+}
+
+// serviceProtocol returns the URL scheme for a detected service, an empty
+// scheme lets HostAndPortToURL guess it from the port number. It returns
+// false when the port is not considered to be web related.
+func serviceProtocol(port int, service string, tunnel string) (string, bool) {
+	if service == "ssl" {
+		return "https", true
+	} else if tunnel == "ssl" && (service != "smtp" && service != "imap" && service != "pop3") {
+		return "https", true
+	} else if service == "http" || service == "http-alt" {
+		return "http", true
+	}
+	return "", isHTTPPort(port)
 }
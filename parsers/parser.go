@@ -0,0 +1,202 @@
+package parsers
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"sort"
+	"sync"
+
+	"github.com/VasilyKaiser/aquasily/core"
+)
+
+// DefaultFormat is used when no registered format recognises the input
+const DefaultFormat = "text"
+
+// Options control how parsers turn input into targets
+type Options struct {
+	// MaxRangeSize limits the number of addresses a single CIDR block
+	// or IP range in the input is expanded to
+	MaxRangeSize int
+	// DeepPaths makes proxy export parsers keep the full path of URLs
+	// instead of reducing them to distinct base URLs
+	DeepPaths bool
+	// Warn reports input which is skipped, nothing is reported if it is nil
+	Warn func(format string, args ...interface{})
+}
+
+func (o Options) warn(format string, args ...interface{}) {
+	if o.Warn != nil {
+		o.Warn(format, args...)
+	}
+}
+
+// DefaultOptions returns the options parsers use by default
+func DefaultOptions() Options {
+	return Options{MaxRangeSize: 65536}
+}
+
+// Parser returns targets parsed from input
+type Parser interface {
+	Parse(r io.Reader) ([]core.Target, error)
+}
+
+// Format describes an input format known to the registry
+type Format struct {
+	Name string
+	// New returns a new parser for the format
+	New func(options Options) Parser
+	// Detect reports whether data is in this format, formats
+	// without a detector are only used when requested by name
+	Detect func(data []byte) bool
+}
+
+var (
+	formatsMutex sync.RWMutex
+	formats      []Format
+
+	masscanListLine = regexp.MustCompile(`^(open|banner) tcp \d+ \S+`)
+	zapURLsStart    = regexp.MustCompile(`^\{\s*"urls"\s*:\s*\[`)
+)
+
+func init() {
+	Register("nmap", func(Options) Parser { return NewNmapParser() }, IsNmapXML)
+	Register("har", func(Options) Parser { return NewHARParser() }, isHAR)
+	Register("burp", func(options Options) Parser { return NewBurpParser(options) }, isBurpXML)
+	Register("zap", func(options Options) Parser { return NewZAPParser(options) }, isZAPReport)
+	Register("masscan-json", func(Options) Parser { return NewMasscanJSONParser() }, isMasscanJSON)
+	Register("masscan-list", func(Options) Parser { return NewMasscanListParser() }, isMasscanList)
+	Register(DefaultFormat, func(options Options) Parser { return NewRegexParser(options) }, nil)
+}
+
+// Register adds a format to the registry. Formats are detected in order of
+// registration, registering an existing name replaces the previous format
+// while keeping its position.
+func Register(name string, constructor func(options Options) Parser, detect func(data []byte) bool) {
+	formatsMutex.Lock()
+	defer formatsMutex.Unlock()
+	format := Format{Name: name, New: constructor, Detect: detect}
+	for i := range formats {
+		if formats[i].Name == name {
+			formats[i] = format
+			return
+		}
+	}
+	formats = append(formats, format)
+}
+
+// Get returns a new parser for the named format with the options
+func Get(name string, options Options) (Parser, error) {
+	formatsMutex.RLock()
+	defer formatsMutex.RUnlock()
+	for _, format := range formats {
+		if format.Name == name {
+			return format.New(options), nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported input format: %s", name)
+}
+
+// Detect returns the name of the first registered format recognising
+// the data or DefaultFormat if there is none
+func Detect(data []byte) string {
+	formatsMutex.RLock()
+	defer formatsMutex.RUnlock()
+	for _, format := range formats {
+		if format.Detect != nil && format.Detect(data) {
+			return format.Name
+		}
+	}
+	return DefaultFormat
+}
+
+// Formats returns sorted names of all registered formats
+func Formats() []string {
+	formatsMutex.RLock()
+	defer formatsMutex.RUnlock()
+	var names []string
+	for _, format := range formats {
+		names = append(names, format.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// firstLine returns the first non-empty line of data
+func firstLine(data []byte) []byte {
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		if line := bytes.TrimSpace(scanner.Bytes()); len(line) > 0 {
+			return line
+		}
+	}
+	return nil
+}
+
+// webTarget returns a URL target for the base URL of rawURL
+// or for its full path if deepPaths is enabled
+func webTarget(rawURL string, source string, deepPaths bool) (core.Target, bool) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return core.Target{}, false
+	}
+	path := "/"
+	if deepPaths && u.Path != "" {
+		path = u.Path
+	}
+	return core.NewURLTarget(fmt.Sprintf("%s://%s%s", u.Scheme, u.Host, path), source), true
+}
+
+func head(data []byte) []byte {
+	if len(data) > 4096 {
+		return data[:4096]
+	}
+	return data
+}
+
+func isHAR(data []byte) bool {
+	h := head(data)
+	return bytes.HasPrefix(firstLine(h), []byte("{")) && bytes.Contains(h, []byte(`"log"`)) &&
+		(bytes.Contains(h, []byte(`"entries"`)) || bytes.Contains(h, []byte(`"creator"`)))
+}
+
+func isBurpXML(data []byte) bool {
+	h := head(data)
+	return bytes.Contains(h, []byte("burpVersion=")) && (bytes.Contains(h, []byte("<items")) || bytes.Contains(h, []byte("<issues")))
+}
+
+func isZAPReport(data []byte) bool {
+	h := head(data)
+	if bytes.Contains(h, []byte("<OWASPZAPReport")) || isZAPSitesTree(h) || isZAPURLs(h) {
+		return true
+	}
+	return bytes.HasPrefix(firstLine(h), []byte("{")) && bytes.Contains(h, []byte(`"@version"`)) && bytes.Contains(h, []byte(`"site"`))
+}
+
+// isZAPSitesTree detects the YAML sites tree export, which starts
+// with the root node named Sites
+func isZAPSitesTree(data []byte) bool {
+	return bytes.Equal(bytes.Join(bytes.Fields(firstLine(data)), []byte(" ")), []byte("- node: Sites"))
+}
+
+// isZAPURLs detects the URL list of the ZAP API
+func isZAPURLs(data []byte) bool {
+	return zapURLsStart.Match(bytes.TrimSpace(head(data)))
+}
+
+func isMasscanJSON(data []byte) bool {
+	line := firstLine(data)
+	if !bytes.Equal(line, []byte("[")) && !bytes.HasPrefix(line, []byte("{")) {
+		return false
+	}
+	h := head(data)
+	return bytes.Contains(h, []byte(`"ip"`)) && bytes.Contains(h, []byte(`"ports"`))
+}
+
+func isMasscanList(data []byte) bool {
+	line := firstLine(data)
+	return bytes.HasPrefix(line, []byte("#masscan")) || masscanListLine.Match(line)
+}
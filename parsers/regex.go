@@ -2,33 +2,86 @@ package parsers
 
 import (
 	"bufio"
+	"fmt"
 	"io"
+	"net/url"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/VasilyKaiser/aquasily/core"
 
 	"github.com/mvdan/xurls"
 )
 
+// ipRangePattern matches fields written as a CIDR block or a dash range
+// of IP addresses, which are expanded or reported if they are invalid
+var ipRangePattern = regexp.MustCompile(`^(\d{1,3}(\.\d{1,3}){3}|[0-9a-fA-F]*:[0-9a-fA-F:.]*)(/\d+|-[0-9a-fA-F.:]+)$`)
+
 // RegexParser structure
-type RegexParser struct{}
+type RegexParser struct {
+	options Options
+}
 
 // NewRegexParser returns RegexParser structure
-func NewRegexParser() *RegexParser {
-	return &RegexParser{}
+func NewRegexParser(options Options) *RegexParser {
+	return &RegexParser{options: options}
 }
 
 // Parse returns parsed targets from input
-func (p *RegexParser) Parse(r io.Reader) ([]string, error) {
-	var targets []string
+func (p *RegexParser) Parse(r io.Reader) ([]core.Target, error) {
+	var targets []core.Target
 	targetsFilter := make(map[string]struct{})
 
 	scanner := bufio.NewScanner(r)
 	for scanner.Scan() {
-		for _, target := range xurls.Relaxed.FindAllString(scanner.Text(), -1) {
+		line := scanner.Text()
+		for _, field := range strings.FieldsFunc(line, isFieldSeparator) {
+			if !ipRangePattern.MatchString(field) {
+				continue
+			}
+			ipRange, err := core.ParseIPRange(field)
+			if err != nil {
+				return targets, fmt.Errorf("invalid IP range %s: %s", field, err)
+			}
+			addrs, err := ipRange.Expand(p.options.MaxRangeSize)
+			if err != nil {
+				p.options.warn("Skipped %s\n", err)
+			}
+			for _, addr := range addrs {
+				if _, found := targetsFilter[addr]; found {
+					continue
+				}
+				targets = append(targets, core.NewHostTarget(addr, "text"))
+				targetsFilter[addr] = struct{}{}
+			}
+			// Ranges are removed so the addresses they start with are not matched again
+			line = strings.Replace(line, field, " ", 1)
+		}
+
+		for _, target := range xurls.Relaxed.FindAllString(line, -1) {
 			if _, found := targetsFilter[target]; found {
 				continue
 			}
-			targets = append(targets, target)
+			if isURL(target) {
+				targets = append(targets, core.NewURLTarget(target, "text"))
+			} else {
+				targets = append(targets, core.NewHostTarget(target, "text"))
+			}
 			targetsFilter[target] = struct{}{}
 		}
 	}
 	return targets, nil
 }
+
+func isFieldSeparator(r rune) bool {
+	return unicode.IsSpace(r) || strings.ContainsRune(`,;"'<>()[]{}|`, r)
+}
+
+func isURL(s string) bool {
+	u, err := url.ParseRequestURI(s)
+	if err != nil {
+		return false
+	}
+	return u.Scheme != ""
+}
@@ -0,0 +1,129 @@
+package parsers
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"strings"
+
+	"github.com/VasilyKaiser/aquasily/core"
+
+	"gopkg.in/yaml.v3"
+)
+
+// zapSiteNode is a node of the ZAP sites tree export, which lists
+// every URL ZAP has seen
+type zapSiteNode struct {
+	Node     string         `yaml:"node"`
+	URL      string         `yaml:"url"`
+	Children []*zapSiteNode `yaml:"children"`
+}
+
+// zapURLs is the URL list returned by the ZAP API at core/view/urls
+type zapURLs struct {
+	URLs []string `json:"urls"`
+}
+
+type zapXMLReport struct {
+	Sites []struct {
+		Name      string   `xml:"name,attr"`
+		Instances []string `xml:"alerts>alertitem>instances>instance>uri"`
+	} `xml:"site"`
+}
+
+type zapJSONReport struct {
+	Sites []struct {
+		Name   string `json:"@name"`
+		Alerts []struct {
+			Instances []struct {
+				URI string `json:"uri"`
+			} `json:"instances"`
+		} `json:"alerts"`
+	} `json:"site"`
+}
+
+// ZAPParser structure
+type ZAPParser struct {
+	options Options
+}
+
+// NewZAPParser returns ZAPParser structure
+func NewZAPParser(options Options) *ZAPParser {
+	return &ZAPParser{options: options}
+}
+
+// Parse returns parsed targets from an OWASP ZAP sites tree export, the URL
+// list of its API, or its XML or JSON report. Reports only list the URLs
+// alerts were raised for, the other formats every URL ZAP has seen
+func (p *ZAPParser) Parse(r io.Reader) ([]core.Target, error) {
+	var targets []core.Target
+	targetsFilter := make(map[string]struct{})
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return targets, err
+	}
+
+	var urls []string
+	if isZAPSitesTree(data) {
+		var tree []*zapSiteNode
+		if err := yaml.Unmarshal(data, &tree); err != nil {
+			return targets, err
+		}
+		var walk func(nodes []*zapSiteNode)
+		walk = func(nodes []*zapSiteNode) {
+			for _, node := range nodes {
+				if node == nil {
+					continue
+				}
+				if node.URL != "" {
+					urls = append(urls, node.URL)
+				}
+				walk(node.Children)
+			}
+		}
+		walk(tree)
+	} else if isZAPURLs(data) {
+		var list zapURLs
+		if err := json.Unmarshal(data, &list); err != nil {
+			return targets, err
+		}
+		urls = list.URLs
+	} else if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		var report zapJSONReport
+		if err := json.Unmarshal(data, &report); err != nil {
+			return targets, err
+		}
+		for _, site := range report.Sites {
+			urls = append(urls, site.Name)
+			for _, alert := range site.Alerts {
+				for _, instance := range alert.Instances {
+					urls = append(urls, instance.URI)
+				}
+			}
+		}
+	} else {
+		var report zapXMLReport
+		if err := xml.Unmarshal(data, &report); err != nil {
+			return targets, err
+		}
+		for _, site := range report.Sites {
+			urls = append(urls, site.Name)
+			urls = append(urls, site.Instances...)
+		}
+	}
+
+	for _, u := range urls {
+		target, ok := webTarget(strings.TrimSpace(u), "zap", p.options.DeepPaths)
+		if !ok {
+			continue
+		}
+		if _, found := targetsFilter[target.URL]; found {
+			continue
+		}
+		targets = append(targets, target)
+		targetsFilter[target.URL] = struct{}{}
+	}
+	return targets, nil
+}
@@ -0,0 +1,547 @@
+// Package runner runs aquasily scans from Go code. A scan is set up from
+// core.Options, targets are fed programmatically and pages are received on
+// a channel as soon as all agents are done with them:
+//
+//	options := core.DefaultOptions()
+//	*options.OutDir = "/tmp"
+//	r, err := runner.New(options)
+//	if err != nil {
+//		return err
+//	}
+//	pages, err := r.Pages()
+//	if err != nil {
+//		return err
+//	}
+//	if err := r.Start(); err != nil {
+//		return err
+//	}
+//	go func() {
+//		r.AddTarget(core.NewURLTarget("https://example.com", "api"))
+//		r.Wait()
+//	}()
+//	for page := range pages {
+//		fmt.Println(page.URL, page.Status)
+//	}
+package runner
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/VasilyKaiser/aquasily/agents"
+	"github.com/VasilyKaiser/aquasily/core"
+	"github.com/VasilyKaiser/aquasily/parsers"
+	"github.com/google/uuid"
+)
+
+// Names of the files written to the output directory
+const (
+	ReportHTML  = "aquasily_report.html"
+	URLsTXT     = "aquasily_urls.txt"
+	SessionJSON = "aquasily_session.json"
+)
+
+// DefaultShutdownTimeout bounds the wait for in-flight work after the
+// session is canceled, unless the runner is given another timeout
+const DefaultShutdownTimeout = 10 * time.Second
+
+// Runner runs the pipeline of a single session
+type Runner struct {
+	Session *core.Session
+	// ShutdownTimeout bounds the wait for in-flight work after the session is canceled
+	ShutdownTimeout time.Duration
+
+	mutex          sync.Mutex
+	seen           map[string]struct{}
+	targets        []core.Target
+	excluded       int
+	registered     bool
+	prepared       bool
+	started        bool
+	finished       bool
+	pages          chan *core.Page
+	pagesClosed    bool
+	pagesSent      map[string]struct{}
+	pagesDone      chan struct{}
+	pagesSenders   sync.WaitGroup
+	checkpointDone chan struct{}
+}
+
+// New returns a runner for a new session with the options, which
+// can be created with core.DefaultOptions
+func New(options core.Options) (*Runner, error) {
+	sess, err := core.NewSessionWithOptions(options)
+	if err != nil {
+		return nil, err
+	}
+	return NewWithSession(sess), nil
+}
+
+// NewWithSession returns a runner for an existing session
+func NewWithSession(sess *core.Session) *Runner {
+	return &Runner{
+		Session:         sess,
+		ShutdownTimeout: DefaultShutdownTimeout,
+		seen:            make(map[string]struct{}),
+	}
+}
+
+// ParserOptions returns the options input is parsed with, taken from
+// the session options
+func (r *Runner) ParserOptions() parsers.Options {
+	options := parsers.DefaultOptions()
+	options.MaxRangeSize = *r.Session.Options.MaxRangeSize
+	options.DeepPaths = *r.Session.Options.DeepPaths
+	options.Warn = r.Session.Out.Warn
+	return options
+}
+
+// Resume restores the session from the session file of an interrupted
+// scan, its targets which were never processed are added to the runner
+func (r *Runner) Resume(filename string) error {
+	if r.started {
+		return errors.New("unable to resume a session which has already started")
+	}
+	if err := r.Session.LoadCheckpoint(filename); err != nil {
+		return err
+	}
+	for _, target := range r.Session.Checkpoint.Targets {
+		if !r.Session.Checkpoint.IsReached(target) {
+			r.AddTarget(target)
+			continue
+		}
+		// Work left on reached targets is replayed from the events
+		r.mutex.Lock()
+		r.seen[target.String()] = struct{}{}
+		r.mutex.Unlock()
+		if target.IsURL() {
+			r.Session.AddTarget(target)
+		}
+	}
+	return nil
+}
+
+// RegisterAgents registers the agents with the session, or the ones
+// selected by the Agents and SkipAgents options if none are given
+func (r *Runner) RegisterAgents(list ...agents.Agent) error {
+	if len(list) == 0 {
+		selected, err := agents.Select(strings.Split(*r.Session.Options.Agents, ","), strings.Split(*r.Session.Options.SkipAgents, ","))
+		if err != nil {
+			return fmt.Errorf("%s, available agents: %s", err, strings.Join(agents.Names(), ", "))
+		}
+		if len(selected) == 0 {
+			return fmt.Errorf("no agents selected, available agents: %s", strings.Join(agents.Names(), ", "))
+		}
+		list = selected
+	}
+	for _, agent := range list {
+		if err := agent.Register(r.Session); err != nil {
+			return fmt.Errorf("unable to register %s: %s", agent.ID(), err)
+		}
+		r.Session.Out.Debug("Registered %s\n", agent.ID())
+		r.Session.Agents = append(r.Session.Agents, agent.ID())
+	}
+	r.registered = true
+	return nil
+}
+
+// Pages returns a channel receiving every page once all agents have
+// finished with it. It has to be called before Start and the channel
+// has to be read until it is closed by Wait
+func (r *Runner) Pages() (<-chan *core.Page, error) {
+	if r.pages != nil {
+		return r.pages, nil
+	}
+	// Makes sure responsive URLs are always handled by someone, so they
+	// are reported even when no page agents are registered
+	if err := r.Session.Subscribe(core.URLResponsive, func(string) {}); err != nil {
+		return nil, err
+	}
+	r.pages = make(chan *core.Page)
+	r.pagesDone = make(chan struct{})
+	r.pagesSent = make(map[string]struct{})
+	r.Session.OnEventDone(core.URLResponsive, func(args []interface{}) {
+		page := r.Session.GetPage(args[0].(string))
+		if page == nil {
+			return
+		}
+		r.mutex.Lock()
+		// URLs published again are handled again, their page is sent once
+		if _, sent := r.pagesSent[page.URL]; sent || r.pagesClosed {
+			r.mutex.Unlock()
+			return
+		}
+		r.pagesSent[page.URL] = struct{}{}
+		r.pagesSenders.Add(1)
+		r.mutex.Unlock()
+		defer r.pagesSenders.Done()
+		select {
+		case r.pages <- page:
+		case <-r.pagesDone:
+		}
+	})
+	return r.pages, nil
+}
+
+// closePages closes the pages channel once no page is being sent anymore
+func (r *Runner) closePages() {
+	if r.pages == nil {
+		return
+	}
+	r.mutex.Lock()
+	r.pagesClosed = true
+	r.mutex.Unlock()
+	close(r.pagesDone)
+	r.pagesSenders.Wait()
+	close(r.pages)
+}
+
+// AddTarget adds the target to the scan, it returns false if the target was
+// added before or is out of scope. Targets added before Start are processed
+// once the runner starts, the later ones right away
+func (r *Runner) AddTarget(target core.Target) bool {
+	r.mutex.Lock()
+	if _, ok := r.seen[target.String()]; ok || r.finished || r.Session.Canceled() {
+		if ok && len(target.Headers) > 0 {
+			r.restoreHeaders(target)
+		}
+		r.mutex.Unlock()
+		return false
+	}
+	r.seen[target.String()] = struct{}{}
+	r.mutex.Unlock()
+	// Checking the scope may resolve the host, which is done unlocked
+	inScope := r.Session.InScope(target.String())
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if !inScope {
+		r.excluded++
+		return false
+	}
+	if r.finished || r.Session.Canceled() {
+		return false
+	}
+	r.Session.Checkpoint.AddTarget(target)
+	r.targets = append(r.targets, target)
+	if r.started {
+		r.publish(target)
+	}
+	return true
+}
+
+// restoreHeaders gives a target restored by Resume the headers read again
+// from the input, they are never written to the session file
+func (r *Runner) restoreHeaders(target core.Target) {
+	for i := range r.targets {
+		if r.targets[i].String() == target.String() {
+			r.targets[i].Headers = target.Headers
+		}
+	}
+	r.Session.SetTargetHeaders(target.String(), target.Headers)
+}
+
+// AddTargets adds all targets and returns how many of them were new
+func (r *Runner) AddTargets(targets []core.Target) int {
+	added := 0
+	for _, target := range targets {
+		if r.AddTarget(target) {
+			added++
+		}
+	}
+	return added
+}
+
+// AddInput parses the input in the format, or the detected one if format is
+// empty or "auto", adds its targets and returns how many of them were new
+func (r *Runner) AddInput(name string, input io.Reader, format string) (int, error) {
+	data, err := io.ReadAll(input)
+	if err != nil {
+		return 0, fmt.Errorf("unable to read input from %s: %s", name, err)
+	}
+	if format == "" || format == "auto" {
+		format = parsers.Detect(data)
+	}
+	parser, err := parsers.Get(format, r.ParserOptions())
+	if err != nil {
+		return 0, fmt.Errorf("%s, supported formats: auto, %s", err, strings.Join(parsers.Formats(), ", "))
+	}
+	r.Session.Out.Debug("Parsing %s as %s\n", name, format)
+	found, err := parser.Parse(bytes.NewReader(data))
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse input from %s as %s: %s", name, format, err)
+	}
+	return r.AddTargets(found), nil
+}
+
+// Targets returns all targets added to the scan
+func (r *Runner) Targets() []core.Target {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return append([]core.Target(nil), r.targets...)
+}
+
+// Excluded returns the number of targets skipped as out of scope
+func (r *Runner) Excluded() int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return r.excluded
+}
+
+func hasSupportedScheme(s string) bool {
+	u, err := url.ParseRequestURI(s)
+	if err != nil {
+		return false
+	}
+	if u.Scheme == "http" || u.Scheme == "https" {
+		return true
+	}
+	return false
+}
+
+func (r *Runner) publish(target core.Target) {
+	if target.IsURL() {
+		if hasSupportedScheme(target.URL) {
+			r.Session.AddTarget(target)
+			r.Session.Publish(core.URL, target.URL)
+		}
+	} else {
+		r.Session.Publish(core.Host, target.Host)
+	}
+	r.Session.Checkpoint.TargetReached(target)
+}
+
+// Prepare creates the output directories of the session, which sets the
+// output directory option to the one actually written to
+func (r *Runner) Prepare() error {
+	if r.prepared {
+		return nil
+	}
+	if err := r.Session.InitDirectories(); err != nil {
+		return err
+	}
+	r.prepared = true
+	return nil
+}
+
+// Start creates the output directories unless Prepare was called, registers
+// the agents selected by the options unless RegisterAgents was called and
+// processes the targets
+func (r *Runner) Start() error {
+	if r.started {
+		return errors.New("runner has already started")
+	}
+	if err := r.Prepare(); err != nil {
+		return err
+	}
+	if !r.registered {
+		if err := r.RegisterAgents(); err != nil {
+			return err
+		}
+	}
+	r.Session.Publish(core.SessionStart)
+	if *r.Session.Options.Resume != "" {
+		replayed, err := r.Session.ReplayCheckpoint()
+		if err != nil {
+			return fmt.Errorf("unable to resume session: %s", err)
+		}
+		r.Session.Out.Important("Resumed %d unfinished events\n", replayed)
+	}
+	r.Session.SetIncomplete(true)
+	r.checkpointDone = make(chan struct{})
+	go r.checkpoint()
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.started = true
+	for _, target := range r.targets {
+		if r.Session.Canceled() {
+			break
+		}
+		r.publish(target)
+	}
+	return nil
+}
+
+// Cancel stops the scan, Wait then returns the partial session
+func (r *Runner) Cancel() {
+	r.Session.Cancel()
+}
+
+// Close releases the session and removes the temporary files of the agents.
+// Wait closes the session itself, Close is needed only when Wait isn't called
+func (r *Runner) Close() {
+	r.Session.Close()
+}
+
+// checkpoint periodically writes the session file until the runner is done
+func (r *Runner) checkpoint() {
+	if *r.Session.Options.CheckpointInterval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(time.Duration(*r.Session.Options.CheckpointInterval) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-r.checkpointDone:
+			return
+		case <-ticker.C:
+			if err := r.Session.SaveToFile(SessionJSON); err != nil {
+				r.Session.Out.Error("Failed to write checkpoint: %s\n", err)
+				continue
+			}
+			r.Session.Out.Debug("Wrote checkpoint, %d events unfinished\n", r.Session.Checkpoint.Unfinished())
+		}
+	}
+}
+
+// waitForPipeline blocks until all published events are handled, or
+// for a bounded time once the session has been canceled
+func (r *Runner) waitForPipeline() {
+	drained := make(chan struct{})
+	go func() {
+		r.Session.Tracker.Wait()
+		close(drained)
+	}()
+	select {
+	case <-drained:
+		return
+	case <-r.Session.Context().Done():
+	}
+	select {
+	case <-drained:
+	case <-time.After(r.ShutdownTimeout):
+		r.Session.Out.Warn("Stopped waiting for in-flight work: %v\n", r.Session.Tracker.Pending())
+	}
+}
+
+// Wait blocks until all targets are processed or the session is canceled,
+// then clusters the pages, writes the session file and returns the session.
+// No targets can be added once Wait is called
+func (r *Runner) Wait() (*core.Session, error) {
+	if !r.started {
+		return nil, errors.New("runner hasn't started")
+	}
+	r.mutex.Lock()
+	r.finished = true
+	r.mutex.Unlock()
+	defer r.Session.Close()
+
+	r.waitForPipeline()
+	r.Session.Publish(core.SessionEnd)
+	r.waitForPipeline()
+	close(r.checkpointDone)
+	r.closePages()
+	if !r.Session.Canceled() {
+		r.Session.SetIncomplete(false)
+		r.Session.Checkpoint.Clear()
+	}
+
+	r.calculatePagesStructure()
+	r.clusterSimilarPages()
+	r.Session.End()
+
+	r.Session.Out.Important("Writing session file...\n")
+	if err := r.Session.SaveToFile(SessionJSON); err != nil {
+		return r.Session, fmt.Errorf("unable to write session file: %s", err)
+	}
+	return r.Session, nil
+}
+
+func (r *Runner) calculatePagesStructure() {
+	sess := r.Session
+	sess.Out.Important("\nCalculating page structures...")
+	f, _ := os.OpenFile(sess.GetFilePath(URLsTXT), os.O_TRUNC|os.O_CREATE|os.O_WRONLY, 0644)
+	// Agents may still be running when the pipeline didn't finish in time
+	for _, page := range sess.PageList() {
+		filename := sess.GetFilePath(fmt.Sprintf("html/%s.html", page.BaseFilename()))
+		body, err := os.Open(filename)
+		if err != nil {
+			continue
+		}
+		structure, _ := core.GetPageStructure(body)
+		body.Close()
+		page.Lock()
+		page.PageStructure = structure
+		page.Unlock()
+		f.WriteString(page.URL + "\n")
+	}
+	f.Close()
+	sess.Out.Important(" done\n")
+}
+
+func (r *Runner) clusterSimilarPages() {
+	sess := r.Session
+	sess.Out.Important("Clustering similar pages...")
+	// All pages are clustered again, including those of a resumed session
+	clusters := make(map[string][]string)
+	pages := sess.PageList()
+	structures := make(map[string][]string)
+	for _, page := range pages {
+		page.Lock()
+		structures[page.URL] = page.PageStructure
+		page.Unlock()
+	}
+	for _, page := range pages {
+		foundCluster := false
+		for clusterUUID, cluster := range clusters {
+			addToCluster := true
+			for _, pageURL := range cluster {
+				structure, ok := structures[pageURL]
+				if ok && core.GetSimilarity(structures[page.URL], structure) < 0.80 {
+					addToCluster = false
+					break
+				}
+			}
+
+			if addToCluster {
+				foundCluster = true
+				clusters[clusterUUID] = append(clusters[clusterUUID], page.URL)
+				break
+			}
+		}
+
+		if !foundCluster {
+			newClusterUUID := uuid.New().String()
+			clusters[newClusterUUID] = []string{page.URL}
+		}
+	}
+	sess.Lock()
+	sess.PageSimilarityClusters = clusters
+	sess.Unlock()
+	sess.Out.Important(" done\n")
+}
+
+// WriteReport renders the HTML report of the session with the template
+// from the options, or the embedded one
+func (r *Runner) WriteReport() error {
+	sess := r.Session
+	sess.Out.Important("Generating HTML report...")
+	var template []byte
+	var err error
+	if *sess.Options.TemplatePath != "" {
+		template, err = os.ReadFile(*sess.Options.TemplatePath)
+	} else {
+		template, err = core.Asset("static/report_template.html")
+	}
+	if err != nil {
+		return fmt.Errorf("can't read report template file: %s", err)
+	}
+	report := core.NewReport(sess, string(template))
+	f, err := os.OpenFile(sess.GetFilePath(ReportHTML), os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return fmt.Errorf("error during report generation: %s", err)
+	}
+	defer f.Close()
+	if err := report.Render(f); err != nil {
+		return fmt.Errorf("error during report generation: %s", err)
+	}
+	sess.Out.Important(" done\n\n")
+	return nil
+}